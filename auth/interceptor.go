@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Access is the minimum level a caller needs to invoke an RPC.
+type Access int
+
+const (
+	// Public RPCs can be called without a token
+	Public Access = iota
+	// Customer RPCs need any signed-in principal
+	Customer
+	// Organizer RPCs need an organizer or an admin
+	Organizer
+	// Admin RPCs need an admin
+	Admin
+)
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	ID   string
+	Role string
+	// Set when the caller signed in with an API key, which acts as its owner
+	// but may only call the full method names in Scopes
+	APIKeyID string
+	Scopes   map[string]bool
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+// CanCall reports whether an API key's scopes cover the method. Sessions may
+// call anything their role allows.
+func (p *Principal) CanCall(method string) bool {
+	return p.APIKeyID == "" || p.Scopes[method]
+}
+
+// Allows reports whether the principal meets the access level.
+func (p *Principal) Allows(access Access) bool {
+	switch access {
+	case Public, Customer:
+		return true
+	case Organizer:
+		return p.Role == RoleOrganizer || p.Role == RoleAdmin
+	case Admin:
+		return p.Role == RoleAdmin
+	}
+	return false
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller attached by the interceptor, if any.
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
+
+// APIKeyHeader is the metadata key an API key may be sent in instead of
+// "authorization: ApiKey <key>".
+const APIKeyHeader = "x-api-key"
+
+// APIKeyVerifier resolves an API key to the principal it acts as.
+type APIKeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, key string) (*Principal, error)
+}
+
+// Authorizer validates bearer tokens or API keys from the "authorization"
+// metadata (the gateway forwards the HTTP Authorization header there) and
+// enforces a per-RPC policy keyed by full method name. Methods missing from
+// the policy are denied.
+type Authorizer struct {
+	tokens *TokenManager
+	keys   APIKeyVerifier
+	policy map[string]Access
+}
+
+func NewAuthorizer(tokens *TokenManager, keys APIKeyVerifier, policy map[string]Access) *Authorizer {
+	return &Authorizer{tokens: tokens, keys: keys, policy: policy}
+}
+
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, method string) (context.Context, error) {
+	access, ok := a.policy[method]
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not allowed", method)
+	}
+
+	principal, err := a.principal(ctx)
+	if access == Public {
+		// Public RPCs still see a valid caller, but a bad token is ignored
+		if err == nil && principal != nil && principal.CanCall(method) {
+			ctx = WithPrincipal(ctx, principal)
+		}
+		return ctx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if principal == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "missing access token")
+	}
+	if !principal.CanCall(method) {
+		return nil, status.Errorf(codes.PermissionDenied, "API key is not scoped for %s", method)
+	}
+	if !principal.Allows(access) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return WithPrincipal(ctx, principal), nil
+}
+
+// principal returns nil without an error when no token was sent.
+func (a *Authorizer) principal(ctx context.Context) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(APIKeyHeader); len(keys) > 0 {
+		return a.apiKeyPrincipal(ctx, keys[0])
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if ok && strings.EqualFold(scheme, "apikey") {
+		return a.apiKeyPrincipal(ctx, token)
+	}
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization must be a bearer token or an API key")
+	}
+
+	claims, err := a.tokens.ParseAccessToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+	return &Principal{ID: claims.Subject, Role: claims.Role}, nil
+}
+
+func (a *Authorizer) apiKeyPrincipal(ctx context.Context, key string) (*Principal, error) {
+	if a.keys == nil || key == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+	}
+	return a.keys.VerifyAPIKey(ctx, key)
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
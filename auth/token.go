@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"eventpass/utils"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Roles carried in access tokens
+const (
+	RoleCustomer  = "customer"
+	RoleOrganizer = "organizer"
+	RoleAdmin     = "admin"
+)
+
+const (
+	defaultIssuer     = "eventpass"
+	defaultAccessTTL  = 15 * time.Minute
+	defaultRefreshTTL = 30 * 24 * time.Hour
+	defaultMFATTL     = 5 * time.Minute
+)
+
+// Purposes of challenge tokens. Access tokens carry no purpose.
+const (
+	// PurposeMFA tokens prove the password step of a login that still needs
+	// a one-time code
+	PurposeMFA = "mfa"
+	// PurposeMFAEnroll tokens let a caller who must set up 2FA enrol before
+	// getting a session
+	PurposeMFAEnroll = "mfa_enroll"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims are the JWT claims of an access token. Subject holds the user or
+// admin ID.
+type Claims struct {
+	Role    string `json:"role"`
+	Purpose string `json:"pur,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// TokenManager signs and verifies access tokens. Tokens are always signed with
+// the active key; every configured key is accepted for verification, so a key
+// can be rotated out by making another one active and removing it once the
+// tokens it signed have expired.
+type TokenManager struct {
+	keys       map[string][]byte
+	activeKID  string
+	issuer     string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+	MFATTL     time.Duration
+}
+
+func NewTokenManager(keys map[string][]byte, activeKID string) (*TokenManager, error) {
+	if _, ok := keys[activeKID]; !ok {
+		return nil, fmt.Errorf("active signing key %q is not configured", activeKID)
+	}
+	return &TokenManager{
+		keys:       keys,
+		activeKID:  activeKID,
+		issuer:     defaultIssuer,
+		AccessTTL:  defaultAccessTTL,
+		RefreshTTL: defaultRefreshTTL,
+		MFATTL:     defaultMFATTL,
+	}, nil
+}
+
+// NewTokenManagerFromEnv reads signing keys from JWT_KEYS as a comma separated
+// list of kid:secret pairs. The first key signs new tokens unless
+// JWT_ACTIVE_KID names another one. ACCESS_TOKEN_TTL, REFRESH_TOKEN_TTL and
+// MFA_TOKEN_TTL accept Go durations. Without JWT_KEYS a random key is generated, which is
+// only suitable for development since tokens do not survive a restart.
+func NewTokenManagerFromEnv() (*TokenManager, error) {
+	keys := map[string][]byte{}
+	activeKID := os.Getenv("JWT_ACTIVE_KID")
+
+	if raw := os.Getenv("JWT_KEYS"); raw != "" {
+		for _, pair := range strings.Split(raw, ",") {
+			kid, secret, ok := strings.Cut(strings.TrimSpace(pair), ":")
+			if !ok || kid == "" || secret == "" {
+				return nil, fmt.Errorf("JWT_KEYS entries must be kid:secret")
+			}
+			keys[kid] = []byte(secret)
+			if activeKID == "" {
+				activeKID = kid
+			}
+		}
+	} else {
+		log.Println("JWT_KEYS not set, generating an ephemeral signing key")
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		activeKID = "dev"
+		keys[activeKID] = secret
+	}
+
+	m, err := NewTokenManager(keys, activeKID)
+	if err != nil {
+		return nil, err
+	}
+	if m.AccessTTL, err = utils.DurationEnv("ACCESS_TOKEN_TTL", defaultAccessTTL); err != nil {
+		return nil, err
+	}
+	if m.RefreshTTL, err = utils.DurationEnv("REFRESH_TOKEN_TTL", defaultRefreshTTL); err != nil {
+		return nil, err
+	}
+	if m.MFATTL, err = utils.DurationEnv("MFA_TOKEN_TTL", defaultMFATTL); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IssueAccessToken returns a signed access token for the subject and its expiry.
+func (m *TokenManager) IssueAccessToken(subject, role string) (string, time.Time, error) {
+	return m.issue(subject, role, "", m.AccessTTL)
+}
+
+// IssueChallengeToken returns a short-lived token for the second step of a
+// login. It is rejected by ParseAccessToken, so it cannot call other RPCs.
+func (m *TokenManager) IssueChallengeToken(subject, role, purpose string) (string, time.Time, error) {
+	return m.issue(subject, role, purpose, m.MFATTL)
+}
+
+// ParseAccessToken verifies the signature and expiry of an access token and
+// returns its claims.
+func (m *TokenManager) ParseAccessToken(tokenString string) (*Claims, error) {
+	claims, err := m.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != "" {
+		return nil, fmt.Errorf("%w: %s token used as access token", ErrInvalidToken, claims.Purpose)
+	}
+	return claims, nil
+}
+
+// ParseChallengeToken verifies a challenge token issued for purpose.
+func (m *TokenManager) ParseChallengeToken(tokenString, purpose string) (*Claims, error) {
+	claims, err := m.parse(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Purpose != purpose {
+		return nil, fmt.Errorf("%w: expected %s token", ErrInvalidToken, purpose)
+	}
+	return claims, nil
+}
+
+func (m *TokenManager) issue(subject, role, purpose string, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	claims := Claims{
+		Role:    role,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = m.activeKID
+	signed, err := token.SignedString(m.keys[m.activeKID])
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+func (m *TokenManager) parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return claims, nil
+}
+
+// NewOpaqueToken returns a random URL-safe token and its hash. Only the hash
+// is stored, so a database leak does not expose usable tokens.
+func NewOpaqueToken() (token string, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
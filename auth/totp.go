@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"image/png"
+	"strings"
+	"time"
+
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	totpIssuer = "EventPass"
+	totpPeriod = 30
+	// Codes from one step either side are accepted to allow for clock drift
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+)
+
+// TOTPKey is a freshly generated authenticator secret.
+type TOTPKey struct {
+	Secret string
+	// URI is the otpauth:// URI authenticator apps import
+	URI string
+	// QRCode is the URI rendered as a PNG QR code
+	QRCode []byte
+}
+
+// NewTOTPKey generates a secret for accountName (shown in the authenticator
+// app next to the issuer).
+func NewTOTPKey(accountName string) (TOTPKey, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: accountName,
+		Period:      totpPeriod,
+		Digits:      otp.DigitsSix,
+		Algorithm:   otp.AlgorithmSHA1,
+	})
+	if err != nil {
+		return TOTPKey{}, err
+	}
+
+	img, err := key.Image(256, 256)
+	if err != nil {
+		return TOTPKey{}, err
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return TOTPKey{}, err
+	}
+	return TOTPKey{Secret: key.Secret(), URI: key.URL(), QRCode: buf.Bytes()}, nil
+}
+
+// VerifyTOTP checks code against secret at time now and returns the time step
+// it matched. Callers should reject steps at or before the last one used, so
+// an observed code cannot be replayed.
+func VerifyTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	step := now.Unix() / totpPeriod
+	for offset := int64(-totpSkew); offset <= totpSkew; offset++ {
+		at := time.Unix((step+offset)*totpPeriod, 0)
+		expected, err := totp.GenerateCodeCustom(secret, at, totp.ValidateOpts{
+			Period:    totpPeriod,
+			Digits:    otp.DigitsSix,
+			Algorithm: otp.AlgorithmSHA1,
+		})
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step + offset, true
+		}
+	}
+	return 0, false
+}
+
+// NewRecoveryCodes returns single-use codes for when the authenticator is
+// lost, formatted like "abcde-fghij". Store them with HashRecoveryCode.
+func NewRecoveryCodes() ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := strings.ToLower(enc.EncodeToString(b))[:10]
+		codes[i] = s[:5] + "-" + s[5:]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normalises a recovery code as typed by a user (case,
+// spaces, dashes) and hashes it.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	return HashToken(code)
+}
@@ -7,10 +7,21 @@ import (
 	"log"
 	"net"
 	"net/http"
-
-	"eventpass/service"
+	"os"
+	"strings"
+	"time"
+
+	"eventpass/auth"
+	"eventpass/jobs"
+	"eventpass/mailer"
+	"eventpass/oidc"
+	"eventpass/payment"
 	"eventpass/proto/gen"
+	repository "eventpass/repository/init"
+	"eventpass/service"
+	"eventpass/tenant"
 	"eventpass/utils"
+	"eventpass/validate"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -18,33 +29,121 @@ import (
 )
 
 func main() {
-	// Initialize database
-	if err := utils.InitDB(); err != nil {
-		log.Fatalf("Failed to initialize database: %v", err)
+	// Schema management and the development IdP run instead of the servers
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "mock-idp" {
+		if err := runMockIdP(os.Args[2:]); err != nil {
+			log.Fatalf("Mock IdP failed: %v", err)
+		}
+		return
+	}
+
+	// STORAGE_BACKEND=memory runs without Postgres; data is lost on exit
+	var repo *repository.Repository
+	if os.Getenv("STORAGE_BACKEND") == "memory" {
+		log.Println("Using in-memory storage")
+		repo = repository.NewMemoryRepository()
+	} else {
+		// Initialize database
+		if err := utils.InitDB(); err != nil {
+			log.Fatalf("Failed to initialize database: %v", err)
+		}
+		defer utils.CloseDB()
+		repo = repository.NewPostgresRepository(utils.DB)
+	}
+
+	// Create the first admin from the environment if configured
+	if err := service.BootstrapAdmin(context.Background(), repo.User); err != nil {
+		log.Fatalf("Failed to bootstrap admin: %v", err)
+	}
+
+	// Load token signing keys
+	tokens, err := auth.NewTokenManagerFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure tokens: %v", err)
+	}
+
+	// Outgoing mail (password resets and notices)
+	mail, err := mailer.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure mailer: %v", err)
+	}
+
+	// Sign-in rules (email verification, mandatory 2FA)
+	policy, err := service.AuthPolicyFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure auth policy: %v", err)
+	}
+
+	// External identity providers for customer sign-in
+	providers, err := oidc.ProvidersFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure identity providers: %v", err)
+	}
+
+	// How long checkout may keep seats aside
+	holdPolicy, err := service.HoldPolicyFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure seat holds: %v", err)
+	}
+
+	// Card processor for paid tickets
+	payments, err := payment.NewFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure payments: %v", err)
 	}
-	defer utils.CloseDB()
+	log.Printf("Using %s payment provider", payments.Name())
+
+	// Move finished events to completed, release unpaid bookings and expired
+	// seat holds, and refund called-off paid bookings in the background
+	go jobs.CompleteEvents(context.Background(), repo.Event, time.Minute)
+	go jobs.ExpireUnpaidBookings(context.Background(), repo.Payment, repo.Booking, payments, time.Minute)
+	go jobs.ReleaseExpiredHolds(context.Background(), repo.Hold, 15*time.Second)
+	go jobs.RefundPayments(context.Background(), repo.Payment, payments, time.Minute)
 
 	// Start gRPC server in a goroutine
-	go startGRPCServer()
+	go startGRPCServer(repo, tokens, mail, policy, holdPolicy, providers, payments)
 
 	// Start HTTP gateway server
-	startHTTPGateway()
+	startHTTPGateway(repo, payments)
 }
 
-func startGRPCServer() {
+func startGRPCServer(repo *repository.Repository, tokens *auth.TokenManager, mail mailer.Mailer, policy service.AuthPolicy, holdPolicy service.HoldPolicy, providers map[string]*oidc.Provider, payments payment.Provider) {
 	lis, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("Failed to listen on port 50051: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Authenticate callers and enforce the per-RPC access policy, pick the
+	// organization the request acts in, then reject malformed requests before
+	// they reach the handlers
+	authorizer := auth.NewAuthorizer(tokens, service.NewAPIKeyAuthenticator(repo.APIKey, repo.User), service.AccessPolicy)
+	resolver := tenant.NewResolver(repo.Org)
+	validator := validate.NewValidator(service.RequestRules)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authorizer.UnaryInterceptor(), resolver.UnaryInterceptor(), validator.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(authorizer.StreamInterceptor()),
+	)
 
 	// Register services
-	userHandler := service.NewUserHandler()
-	eventHandler := service.NewEventHandler()
+	userHandler := service.NewUserHandler(repo.User, repo.Session, repo.UserToken, repo.MFA, repo.Throttle, repo.OIDC, providers, repo.Payment, payments, tokens, mail, policy)
+	eventHandler := service.NewEventHandler(repo.Event, repo.Booking, repo.Member, repo.User, repo.Org, repo.Payment, payments, mail)
+	bookingHandler := service.NewBookingHandler(repo.Booking, repo.User, repo.Event, repo.Member, repo.Org, repo.Payment, repo.Hold, payments, policy, holdPolicy)
+	adminHandler := service.NewAdminHandler(repo.User, repo.Session, repo.UserToken, repo.Throttle, mail)
+	orgHandler := service.NewOrganizationHandler(repo.Org, repo.User)
+	apiKeyHandler := service.NewAPIKeyHandler(repo.APIKey)
 
 	gen.RegisterUserServiceServer(grpcServer, userHandler)
 	gen.RegisterEventServiceServer(grpcServer, eventHandler)
+	gen.RegisterBookingServiceServer(grpcServer, bookingHandler)
+	gen.RegisterAdminServiceServer(grpcServer, adminHandler)
+	gen.RegisterOrganizationServiceServer(grpcServer, orgHandler)
+	gen.RegisterApiKeyServiceServer(grpcServer, apiKeyHandler)
 
 	log.Println("gRPC server starting on :50051")
 	if err := grpcServer.Serve(lis); err != nil {
@@ -52,7 +151,7 @@ func startGRPCServer() {
 	}
 }
 
-func startHTTPGateway() {
+func startHTTPGateway(repo *repository.Repository, payments payment.Provider) {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -60,6 +159,7 @@ func startHTTPGateway() {
 	// Create a gRPC-Gateway mux
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{}),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
 	)
 
 	// Register gRPC services to the gateway
@@ -75,6 +175,26 @@ func startHTTPGateway() {
 		log.Fatalf("Failed to register event service handler: %v", err)
 	}
 
+	err = gen.RegisterBookingServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
+	if err != nil {
+		log.Fatalf("Failed to register booking service handler: %v", err)
+	}
+
+	err = gen.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
+	if err != nil {
+		log.Fatalf("Failed to register admin service handler: %v", err)
+	}
+
+	err = gen.RegisterOrganizationServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
+	if err != nil {
+		log.Fatalf("Failed to register organization service handler: %v", err)
+	}
+
+	err = gen.RegisterApiKeyServiceHandlerFromEndpoint(ctx, mux, "localhost:50051", opts)
+	if err != nil {
+		log.Fatalf("Failed to register API key service handler: %v", err)
+	}
+
 	// Create HTTP server with CORS
 	httpMux := http.NewServeMux()
 
@@ -82,8 +202,8 @@ func startHTTPGateway() {
 	httpMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		// Enable CORS
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Organization, X-Api-Key")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -93,6 +213,13 @@ func startHTTPGateway() {
 		mux.ServeHTTP(w, r)
 	})
 
+	// Payment webhooks are signed over the raw body, so they bypass the
+	// gateway; the fake processor's checkout is served for development
+	httpMux.Handle("/webhooks/payments/"+payments.Name(), service.NewPaymentWebhook(repo.Payment, payments))
+	if fake, ok := payments.(*payment.Fake); ok {
+		httpMux.Handle("/fake-payments/", http.StripPrefix("/fake-payments", fake))
+	}
+
 	// Serve static files (optional)
 	httpMux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir("./static/"))))
 
@@ -103,3 +230,15 @@ func startHTTPGateway() {
 		log.Fatalf("Failed to start HTTP gateway server: %v", err)
 	}
 }
+
+// headerMatcher passes the organization and API key headers through to the
+// gRPC server alongside the gateway's defaults.
+func headerMatcher(key string) (string, bool) {
+	switch {
+	case strings.EqualFold(key, tenant.Header):
+		return tenant.Header, true
+	case strings.EqualFold(key, auth.APIKeyHeader):
+		return auth.APIKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -0,0 +1,80 @@
+// cmd/migrate.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"eventpass/migrations"
+	"eventpass/utils"
+)
+
+const migrateUsage = `usage: eventpass migrate <command>
+
+commands:
+  status       list migrations and whether they are applied
+  up           apply all pending migrations
+  down [N]     roll back the last N migrations (default 1)
+  to VERSION   migrate up or down to VERSION (0 rolls back everything)`
+
+// runMigrate implements the "migrate" subcommand.
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", migrateUsage)
+	}
+
+	if err := utils.ConnectDB(); err != nil {
+		return err
+	}
+	defer utils.CloseDB()
+
+	migrator, err := migrations.New(utils.DB)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			applied := "pending"
+			if s.AppliedAt != nil {
+				applied = s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, applied)
+		}
+		return w.Flush()
+
+	case "up":
+		return migrator.Up(ctx)
+
+	case "down":
+		n := 1
+		if len(args) > 1 {
+			if n, err = strconv.Atoi(args[1]); err != nil || n < 1 {
+				return fmt.Errorf("down expects a positive number of migrations")
+			}
+		}
+		return migrator.Down(ctx, n)
+
+	case "to":
+		if len(args) < 2 {
+			return fmt.Errorf("to expects a version")
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 0 {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		return migrator.To(ctx, version)
+	}
+	return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+}
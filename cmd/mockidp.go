@@ -0,0 +1,37 @@
+// cmd/mockidp.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	"eventpass/oidc"
+)
+
+// runMockIdP implements the "mock-idp" subcommand: a local OpenID Connect
+// provider for trying external sign-in without a real one. Point a provider
+// at it with e.g. OIDC_PROVIDERS=mock, OIDC_MOCK_ISSUER=http://localhost:9000,
+// OIDC_MOCK_CLIENT_ID=eventpass and OIDC_MOCK_REDIRECT_URL set to the page
+// that calls CompleteOIDCLogin.
+func runMockIdP(args []string) error {
+	flags := flag.NewFlagSet("mock-idp", flag.ContinueOnError)
+	addr := flags.String("addr", ":9000", "address to listen on")
+	issuer := flags.String("issuer", "", "issuer URL (default http://localhost<addr>)")
+	email := flags.String("email", "mock.user@example.com", "user to sign in when the request has no login_hint")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *issuer == "" {
+		*issuer = "http://localhost" + *addr
+	}
+
+	idp, err := oidc.NewMockIdP(*issuer, *email)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Mock identity provider %s listening on %s\n", *issuer, *addr)
+	log.Println("The mock IdP signs anyone in without a password; do not expose it")
+	return http.ListenAndServe(*addr, idp)
+}
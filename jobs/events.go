@@ -0,0 +1,36 @@
+// Package jobs holds background work that runs alongside the servers.
+package jobs
+
+import (
+	"context"
+	intf "eventpass/repository/intf"
+	"log"
+	"time"
+)
+
+// CompleteEvents marks published events as completed once their end time has
+// passed. It runs every interval until ctx is cancelled.
+func CompleteEvents(ctx context.Context, events intf.EventRepository, interval time.Duration) {
+	run := func() {
+		n, err := events.CompleteFinishedEvents(ctx, time.Now())
+		if err != nil {
+			log.Printf("Failed to complete finished events: %v", err)
+			return
+		}
+		if n > 0 {
+			log.Printf("Marked %d events as completed", n)
+		}
+	}
+
+	run()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
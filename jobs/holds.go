@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	intf "eventpass/repository/intf"
+	"log"
+	"time"
+)
+
+// ReleaseExpiredHolds gives the slots of expired seat holds back to their
+// events. It runs every interval until ctx is cancelled.
+func ReleaseExpiredHolds(ctx context.Context, holds intf.SeatHoldRepository, interval time.Duration) {
+	run := func() {
+		n, err := holds.ReleaseExpiredHolds(ctx, time.Now().UTC(), expireBatch)
+		if n > 0 {
+			log.Printf("Released %d expired seat holds", n)
+		}
+		if err != nil {
+			log.Printf("Failed to release expired seat holds: %v", err)
+		}
+	}
+
+	run()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package jobs
+
+import (
+	"context"
+	"eventpass/payment"
+	intf "eventpass/repository/intf"
+	"fmt"
+	"log"
+	"time"
+)
+
+// expireBatch caps how many bookings one run expires.
+const expireBatch = 100
+
+// retryDelay is how long a payment whose cancellation or refund failed is
+// left alone before the next attempt, so it does not hold up the rest.
+const retryDelay = 5 * time.Minute
+
+// ExpireUnpaidBookings returns the slots of bookings whose payment did not
+// come through before their hold ran out. The payment is cancelled with the
+// processor first; if that fails the customer may be paying right now, so the
+// booking is left for the webhook or a later run. Bookings that never got a
+// payment are expired as well. It runs every interval until ctx is cancelled.
+func ExpireUnpaidBookings(ctx context.Context, payments intf.PaymentRepository, bookings intf.BookingRepository, provider payment.Provider, interval time.Duration) {
+	run := func() {
+		now := time.Now().UTC()
+		expired, err := payments.ListExpiredPayments(ctx, now, expireBatch)
+		if err != nil {
+			log.Printf("Failed to list expired payments: %v", err)
+			return
+		}
+		var bookingIDs []string
+		for _, p := range expired {
+			// Intents of a processor we no longer use cannot be reached
+			if p.Provider == provider.Name() {
+				if err := provider.CancelIntent(ctx, p.IntentID); err != nil {
+					log.Printf("Failed to cancel payment intent %s: %v", p.IntentID, err)
+					if err := payments.DeferPayment(ctx, p.PaymentID, now.Add(retryDelay)); err != nil {
+						log.Printf("Failed to defer payment %s: %v", p.PaymentID, err)
+					}
+					continue
+				}
+			}
+			bookingIDs = append(bookingIDs, p.BookingID)
+		}
+
+		abandoned, err := bookings.ListAbandonedBookings(ctx, now, expireBatch)
+		if err != nil {
+			log.Printf("Failed to list abandoned bookings: %v", err)
+		}
+		bookingIDs = append(bookingIDs, abandoned...)
+
+		n := 0
+		for _, id := range bookingIDs {
+			if err := bookings.ExpireBooking(ctx, id, now); err != nil {
+				log.Printf("Failed to expire booking %s: %v", id, err)
+				continue
+			}
+			n++
+		}
+		if n > 0 {
+			log.Printf("Released %d unpaid bookings", n)
+		}
+	}
+
+	run()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
+
+// RefundPayments returns the money of paid bookings that were called off:
+// cancelled with their event, or paid only after their hold ran out. Failed
+// refunds are retried after retryDelay. It runs every interval until
+// ctx is cancelled.
+func RefundPayments(ctx context.Context, payments intf.PaymentRepository, provider payment.Provider, interval time.Duration) {
+	run := func() {
+		now := time.Now().UTC()
+		refunds, err := payments.ListPendingRefunds(ctx, now, expireBatch)
+		if err != nil {
+			log.Printf("Failed to list pending refunds: %v", err)
+			return
+		}
+
+		n := 0
+		for _, p := range refunds {
+			err := fmt.Errorf("%s is not the current payment provider", p.Provider)
+			if p.Provider == provider.Name() {
+				err = provider.Refund(ctx, p.IntentID)
+			}
+			if err != nil {
+				log.Printf("Failed to refund payment intent %s: %v", p.IntentID, err)
+				if err := payments.DeferPayment(ctx, p.PaymentID, now.Add(retryDelay)); err != nil {
+					log.Printf("Failed to defer payment %s: %v", p.PaymentID, err)
+				}
+				continue
+			}
+			if err := payments.MarkPaymentRefunded(ctx, p.PaymentID); err != nil {
+				log.Printf("Failed to mark payment %s refunded: %v", p.PaymentID, err)
+				continue
+			}
+			n++
+		}
+		if n > 0 {
+			log.Printf("Refunded %d payments", n)
+		}
+	}
+
+	run()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run()
+		}
+	}
+}
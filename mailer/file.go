@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync"
+)
+
+// FileMailer appends every message to a file instead of sending it, for local
+// development and tests that need to read the mail back.
+type FileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+func NewFileMailer(path, from string) *FileMailer {
+	return &FileMailer{path: path, from: from}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data := append(format(m.from, msg), "\r\n\r\n"...)
+	_, err = f.Write(data)
+	return err
+}
+
+// LogMailer writes messages to the server log.
+type LogMailer struct {
+	from string
+}
+
+func NewLogMailer(from string) *LogMailer {
+	return &LogMailer{from: from}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	log.Printf("📧 Mail from %s to %s: %s\n%s", m.from, msg.To, msg.Subject, msg.Body)
+	return nil
+}
@@ -0,0 +1,60 @@
+// Package mailer sends the transactional emails of the service (password
+// resets and account notices).
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers messages. Implementations must be safe for concurrent use.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewFromEnv picks the transport from MAIL_TRANSPORT:
+//
+//   - "smtp" sends through SMTP_HOST:SMTP_PORT (default 587), authenticating
+//     with SMTP_USERNAME and SMTP_PASSWORD when set
+//   - "file" appends messages to MAIL_FILE (default "mail.log")
+//   - "log" (the default) writes messages to the server log
+//
+// MAIL_FROM sets the sender address.
+func NewFromEnv() (Mailer, error) {
+	from := getEnv("MAIL_FROM", "EventPass <no-reply@eventpass.local>")
+
+	switch transport := getEnv("MAIL_TRANSPORT", "log"); transport {
+	case "smtp":
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required when MAIL_TRANSPORT is smtp")
+		}
+		port, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMTP_PORT: %w", err)
+		}
+		return NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from), nil
+	case "file":
+		return NewFileMailer(getEnv("MAIL_FILE", "mail.log"), from), nil
+	case "log":
+		return NewLogMailer(from), nil
+	default:
+		return nil, fmt.Errorf("unknown MAIL_TRANSPORT %q", transport)
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,12 @@
+-- Baseline migrations use IF NOT EXISTS so they also apply cleanly to
+-- databases created before migrations existed.
+CREATE TABLE IF NOT EXISTS users (
+	user_id VARCHAR(36) PRIMARY KEY,
+	first_name VARCHAR(100) NOT NULL,
+	last_name VARCHAR(100) NOT NULL,
+	username VARCHAR(50) UNIQUE NOT NULL,
+	password VARCHAR(255) NOT NULL,
+	phone VARCHAR(15) NOT NULL,
+	email VARCHAR(100) UNIQUE NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS events (
+	event_id VARCHAR(36) PRIMARY KEY,
+	event_title VARCHAR(200) NOT NULL,
+	event_description TEXT,
+	event_location VARCHAR(255) NOT NULL,
+	event_date DATE NOT NULL,
+	event_start_time TIME NOT NULL,
+	event_end_time TIME NOT NULL,
+	created_by VARCHAR(100) NOT NULL,
+	total_slots INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
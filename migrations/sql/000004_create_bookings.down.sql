@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS bookings;
+ALTER TABLE events DROP COLUMN IF EXISTS booked_slots;
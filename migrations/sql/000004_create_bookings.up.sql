@@ -0,0 +1,12 @@
+-- Slots taken by confirmed bookings; never exceeds total_slots
+ALTER TABLE events ADD COLUMN IF NOT EXISTS booked_slots INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS bookings (
+	booking_id VARCHAR(36) PRIMARY KEY,
+	event_id VARCHAR(36) NOT NULL REFERENCES events(event_id),
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id),
+	status VARCHAR(20) NOT NULL DEFAULT 'confirmed',
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	cancelled_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS bookings_user_id_idx ON bookings (user_id, created_at DESC);
@@ -0,0 +1,14 @@
+-- Every login starts a token family and each rotation adds a token to it, so
+-- reuse of a rotated token can revoke the whole family
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+	token_id VARCHAR(36) PRIMARY KEY,
+	family_id VARCHAR(36) NOT NULL,
+	subject_id VARCHAR(36) NOT NULL,
+	role VARCHAR(20) NOT NULL,
+	token_hash CHAR(64) UNIQUE NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	revoked_at TIMESTAMP,
+	replaced_by VARCHAR(36)
+);
+CREATE INDEX IF NOT EXISTS refresh_tokens_family_id_idx ON refresh_tokens (family_id);
@@ -0,0 +1,2 @@
+-- Optimistic concurrency token for UpdateEvent
+ALTER TABLE events ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
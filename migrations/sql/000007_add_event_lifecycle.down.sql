@@ -0,0 +1,5 @@
+ALTER TABLE bookings DROP COLUMN cancel_reason;
+
+DROP INDEX IF EXISTS events_status_end_idx;
+ALTER TABLE events DROP COLUMN cancel_reason;
+ALTER TABLE events DROP COLUMN status;
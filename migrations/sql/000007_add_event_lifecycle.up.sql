@@ -0,0 +1,10 @@
+-- Events that already exist were live, so they start out published; new
+-- events start as drafts
+ALTER TABLE events ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'published';
+ALTER TABLE events ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE events ADD CONSTRAINT events_status_check
+	CHECK (status IN ('draft', 'published', 'cancelled', 'completed'));
+ALTER TABLE events ADD COLUMN cancel_reason TEXT;
+CREATE INDEX events_status_end_idx ON events (status, event_date);
+
+ALTER TABLE bookings ADD COLUMN cancel_reason TEXT;
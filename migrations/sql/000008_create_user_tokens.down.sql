@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS refresh_tokens_subject_id_idx;
+DROP TABLE IF EXISTS user_tokens;
@@ -0,0 +1,13 @@
+-- Single-use tokens mailed to users (password reset links and the like).
+-- Only the SHA-256 of the token is stored.
+CREATE TABLE IF NOT EXISTS user_tokens (
+	token_id VARCHAR(36) PRIMARY KEY,
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+	purpose VARCHAR(32) NOT NULL,
+	token_hash CHAR(64) UNIQUE NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	used_at TIMESTAMP,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS user_tokens_user_id_idx ON user_tokens (user_id, purpose);
+CREATE INDEX IF NOT EXISTS refresh_tokens_subject_id_idx ON refresh_tokens (subject_id);
@@ -0,0 +1,18 @@
+-- One TOTP authenticator per user or admin. The secret must be readable to
+-- check codes; it is only usable once confirmed_at is set.
+CREATE TABLE IF NOT EXISTS mfa_factors (
+	subject_id VARCHAR(36) PRIMARY KEY,
+	secret VARCHAR(64) NOT NULL,
+	confirmed_at TIMESTAMP,
+	-- Last TOTP time step accepted, so a code cannot be used twice
+	last_used_step BIGINT NOT NULL DEFAULT 0,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+	code_id VARCHAR(36) PRIMARY KEY,
+	subject_id VARCHAR(36) NOT NULL REFERENCES mfa_factors(subject_id) ON DELETE CASCADE,
+	code_hash CHAR(64) NOT NULL,
+	used_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS mfa_recovery_codes_subject_id_idx ON mfa_recovery_codes (subject_id);
@@ -0,0 +1,9 @@
+-- Failed sign-in attempts per account ("user:alice") and per client IP
+-- ("ip:203.0.113.7"). Rows are removed on a successful login.
+CREATE TABLE IF NOT EXISTS login_throttles (
+	throttle_key VARCHAR(150) PRIMARY KEY,
+	failures INTEGER NOT NULL DEFAULT 0,
+	locked_until TIMESTAMP,
+	last_failure_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS login_throttles_last_failure_idx ON login_throttles (last_failure_at DESC);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS users_created_at_idx;
+ALTER TABLE users DROP COLUMN IF EXISTS suspend_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,9 @@
+-- Users can be promoted to organizer; admins live in their own table
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'customer'
+	CONSTRAINT users_role_check CHECK (role IN ('customer', 'organizer'));
+
+-- Suspended users cannot log in or book
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspended_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS suspend_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS users_created_at_idx ON users (created_at DESC);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS bookings_event_id_idx;
+DROP INDEX IF EXISTS events_created_by_idx;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_creator_check;
+ALTER TABLE events DROP COLUMN IF EXISTS created_by_admin;
+ALTER TABLE events DROP COLUMN IF EXISTS created_by_user;
@@ -0,0 +1,17 @@
+-- created_by used to be free text. The creator is now a real account: a user
+-- with the organizer role or an admin. created_by keeps the ID for display.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS created_by_user VARCHAR(36) REFERENCES users(user_id);
+ALTER TABLE events ADD COLUMN IF NOT EXISTS created_by_admin VARCHAR(36) REFERENCES admins(admin_id);
+
+UPDATE events e SET created_by_user = e.created_by
+	WHERE EXISTS (SELECT 1 FROM users u WHERE u.user_id = e.created_by);
+UPDATE events e SET created_by_admin = e.created_by
+	WHERE EXISTS (SELECT 1 FROM admins a WHERE a.admin_id = e.created_by);
+
+-- Exactly one creator for new rows; older events with an unknown creator are
+-- left alone and can only be managed by admins
+ALTER TABLE events ADD CONSTRAINT events_creator_check
+	CHECK ((created_by_user IS NULL) <> (created_by_admin IS NULL)) NOT VALID;
+
+CREATE INDEX IF NOT EXISTS events_created_by_idx ON events (created_by, created_at DESC);
+CREATE INDEX IF NOT EXISTS bookings_event_id_idx ON bookings (event_id, created_at);
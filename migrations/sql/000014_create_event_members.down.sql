@@ -0,0 +1,2 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS checked_in_at;
+DROP TABLE IF EXISTS event_members;
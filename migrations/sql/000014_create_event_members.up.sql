@@ -0,0 +1,14 @@
+-- People who help run an event besides its creator, who is always an owner.
+-- Invitations are pending until accepted_at is set.
+CREATE TABLE IF NOT EXISTS event_members (
+	event_id VARCHAR(36) NOT NULL REFERENCES events(event_id) ON DELETE CASCADE,
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+	role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'co_organizer', 'checkin_staff', 'viewer')),
+	invited_by VARCHAR(36) NOT NULL,
+	invited_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	accepted_at TIMESTAMP,
+	PRIMARY KEY (event_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS event_members_user_id_idx ON event_members (user_id);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS checked_in_at TIMESTAMP;
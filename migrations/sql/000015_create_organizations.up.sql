@@ -0,0 +1,32 @@
+-- Client organisations. Events belong to exactly one; users join any number
+-- of them with a role.
+CREATE TABLE IF NOT EXISTS organizations (
+	org_id VARCHAR(36) PRIMARY KEY,
+	slug VARCHAR(50) UNIQUE NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	logo_url VARCHAR(500) NOT NULL DEFAULT '',
+	primary_color VARCHAR(7) NOT NULL DEFAULT '',
+	support_email VARCHAR(100) NOT NULL DEFAULT '',
+	members_only BOOLEAN NOT NULL DEFAULT FALSE,
+	max_event_slots INTEGER NOT NULL DEFAULT 0 CHECK (max_event_slots >= 0),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS organization_members (
+	org_id VARCHAR(36) NOT NULL REFERENCES organizations(org_id) ON DELETE CASCADE,
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+	role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'organizer', 'member')),
+	joined_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (org_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS organization_members_user_id_idx ON organization_members (user_id);
+
+-- Everything that existed before organizations moves into the default one
+INSERT INTO organizations (org_id, slug, name)
+	VALUES ('00000000-0000-0000-0000-000000000001', 'default', 'EventPass')
+	ON CONFLICT DO NOTHING;
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS org_id VARCHAR(36) REFERENCES organizations(org_id);
+UPDATE events SET org_id = '00000000-0000-0000-0000-000000000001' WHERE org_id IS NULL;
+ALTER TABLE events ALTER COLUMN org_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS events_org_id_idx ON events (org_id, event_date);
@@ -0,0 +1,15 @@
+-- Keys for server-to-server integrations. The owner is a user or an admin,
+-- like the subject of a refresh token, and the key acts as them.
+CREATE TABLE IF NOT EXISTS api_keys (
+	key_id VARCHAR(36) PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	prefix VARCHAR(16) NOT NULL,
+	key_hash CHAR(64) UNIQUE NOT NULL,
+	scopes TEXT[] NOT NULL,
+	owner_id VARCHAR(36) NOT NULL,
+	owner_role VARCHAR(20) NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	last_used_at TIMESTAMP,
+	revoked_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS api_keys_owner_id_idx ON api_keys (owner_id);
@@ -0,0 +1,21 @@
+-- Sign-ins with an external provider that are waiting for the user to come
+-- back, found by the hash of their state parameter
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+	state_hash CHAR(64) PRIMARY KEY,
+	provider VARCHAR(50) NOT NULL,
+	code_verifier VARCHAR(128) NOT NULL,
+	nonce VARCHAR(128) NOT NULL,
+	expires_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS oidc_login_states_expires_at_idx ON oidc_login_states (expires_at);
+
+-- Accounts at external providers linked to users
+CREATE TABLE IF NOT EXISTS user_identities (
+	provider VARCHAR(50) NOT NULL,
+	subject VARCHAR(255) NOT NULL,
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+	email VARCHAR(100) NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (provider, subject)
+);
+CREATE INDEX IF NOT EXISTS user_identities_user_id_idx ON user_identities (user_id);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS bookings_tier_id_idx;
+ALTER TABLE bookings DROP COLUMN IF EXISTS currency;
+ALTER TABLE bookings DROP COLUMN IF EXISTS unit_price_minor;
+ALTER TABLE bookings DROP COLUMN IF EXISTS quantity;
+ALTER TABLE bookings DROP COLUMN IF EXISTS tier_name;
+ALTER TABLE bookings DROP COLUMN IF EXISTS tier_id;
+DROP TABLE IF EXISTS ticket_tiers;
@@ -0,0 +1,28 @@
+-- Kinds of tickets sold for an event. Each tier has its own capacity on top
+-- of the event's total_slots; sold never passes either.
+CREATE TABLE IF NOT EXISTS ticket_tiers (
+	tier_id VARCHAR(36) PRIMARY KEY,
+	event_id VARCHAR(36) NOT NULL REFERENCES events(event_id) ON DELETE CASCADE,
+	name VARCHAR(100) NOT NULL,
+	price_minor BIGINT NOT NULL DEFAULT 0 CHECK (price_minor >= 0),
+	currency VARCHAR(3) NOT NULL DEFAULT '',
+	capacity INTEGER NOT NULL CHECK (capacity > 0),
+	sold INTEGER NOT NULL DEFAULT 0 CHECK (sold >= 0 AND sold <= capacity),
+	sales_start TIMESTAMP,
+	sales_end TIMESTAMP,
+	max_per_order INTEGER NOT NULL DEFAULT 0 CHECK (max_per_order >= 0),
+	position INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (event_id, name),
+	CHECK (sales_end IS NULL OR sales_start IS NULL OR sales_end > sales_start)
+);
+CREATE INDEX IF NOT EXISTS ticket_tiers_event_id_idx ON ticket_tiers (event_id, position);
+
+-- A booking now holds one or more tickets of a tier. Existing bookings are
+-- single free tickets of events without tiers.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS tier_id VARCHAR(36) REFERENCES ticket_tiers(tier_id) ON DELETE SET NULL;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS tier_name VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0);
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS unit_price_minor BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS bookings_tier_id_idx ON bookings (tier_id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS payment_webhook_events;
+DROP TABLE IF EXISTS payments;
+DROP INDEX IF EXISTS bookings_pending_expires_at_idx;
+ALTER TABLE bookings DROP COLUMN IF EXISTS expires_at;
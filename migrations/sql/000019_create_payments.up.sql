@@ -0,0 +1,26 @@
+-- Paid bookings hold their slots in pending_payment until expires_at
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP;
+CREATE INDEX IF NOT EXISTS bookings_pending_expires_at_idx ON bookings (expires_at) WHERE status = 'pending_payment';
+
+-- The charge for a paid booking at the payment provider
+CREATE TABLE IF NOT EXISTS payments (
+	payment_id VARCHAR(36) PRIMARY KEY,
+	booking_id VARCHAR(36) UNIQUE NOT NULL REFERENCES bookings(booking_id) ON DELETE CASCADE,
+	provider VARCHAR(20) NOT NULL,
+	intent_id VARCHAR(255) NOT NULL,
+	amount BIGINT NOT NULL CHECK (amount > 0),
+	currency VARCHAR(3) NOT NULL,
+	status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'succeeded', 'failed', 'cancelled')),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (provider, intent_id)
+);
+
+-- Webhooks already handled, so redeliveries are acknowledged without
+-- being applied twice
+CREATE TABLE IF NOT EXISTS payment_webhook_events (
+	provider VARCHAR(20) NOT NULL,
+	event_id VARCHAR(255) NOT NULL,
+	received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (provider, event_id)
+);
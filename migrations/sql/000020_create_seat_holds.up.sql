@@ -0,0 +1,14 @@
+-- Tickets kept aside while a customer checks out. A hold's quantity is
+-- counted in the event's booked_slots and its tier's sold until it is
+-- released or booked.
+CREATE TABLE IF NOT EXISTS seat_holds (
+	hold_id VARCHAR(36) PRIMARY KEY,
+	event_id VARCHAR(36) NOT NULL REFERENCES events(event_id) ON DELETE CASCADE,
+	tier_id VARCHAR(36) REFERENCES ticket_tiers(tier_id) ON DELETE CASCADE,
+	user_id VARCHAR(36) NOT NULL REFERENCES users(user_id),
+	quantity INTEGER NOT NULL CHECK (quantity > 0),
+	expires_at TIMESTAMP NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (event_id, user_id)
+);
+CREATE INDEX IF NOT EXISTS seat_holds_expires_at_idx ON seat_holds (expires_at);
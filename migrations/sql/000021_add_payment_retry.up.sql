@@ -0,0 +1,3 @@
+-- When the expiry job may next try to cancel an unpaid payment whose
+-- cancellation failed, so it does not retry the same ones every run
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS retry_at TIMESTAMP;
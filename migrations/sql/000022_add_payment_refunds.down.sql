@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS payments_refund_pending_idx;
+UPDATE payments SET status = 'succeeded' WHERE status IN ('refund_pending', 'refunded');
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+	CHECK (status IN ('pending', 'succeeded', 'failed', 'cancelled'));
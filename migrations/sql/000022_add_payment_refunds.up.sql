@@ -0,0 +1,7 @@
+-- Payments of bookings called off after the money was taken wait in
+-- refund_pending until the processor has returned it; retry_at also spaces
+-- out failed refund attempts
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+	CHECK (status IN ('pending', 'succeeded', 'failed', 'cancelled', 'refund_pending', 'refunded'));
+CREATE INDEX IF NOT EXISTS payments_refund_pending_idx ON payments (retry_at) WHERE status = 'refund_pending';
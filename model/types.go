@@ -1,16 +1,25 @@
 package model
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type User struct {
 	UserID    string    `json:"user_id"`
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
 	Username  string    `json:"username"`
-	Password  string    `json:"password"`
+	Password  string    `json:"-"`
 	Phone     string    `json:"phone"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
+	// EmailVerifiedAt is nil until the user follows the verification link
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+	// Role is "customer" or "organizer"
+	Role          string     `json:"role"`
+	SuspendedAt   *time.Time `json:"suspended_at"`
+	SuspendReason string     `json:"suspend_reason"`
 }
 type Event struct {
 	Event_ID          string    `json:"event_id"`
@@ -21,16 +30,443 @@ type Event struct {
 	Event_End_Time    time.Time `json:"end_time"`
 	Event_Location    string    `json:"location"`
 	TotalSlots        int       `json:"total_slots"`
-	CreatedBy         string `json:"created_by"`
+	BookedSlots       int       `json:"booked_slots"`
+	Version           int       `json:"version"`
+	Status            string    `json:"status"`
+	CancelReason      string    `json:"cancel_reason"`
+	CreatedBy         string    `json:"created_by"`
 	CreatedAt         time.Time `json:"created_at"`
+	OrgID             string    `json:"org_id"`
 }
+
+// TicketTier is a kind of ticket sold for an event, such as early bird or
+// VIP. Tiers share the event's total_slots; each also has its own capacity.
+// Events without tiers are booked as free general admission.
+type TicketTier struct {
+	TierID  string `json:"tier_id"`
+	EventID string `json:"event_id"`
+	Name    string `json:"name"`
+	// PriceMinor is in the currency's minor unit (cents for USD)
+	PriceMinor int64  `json:"price_minor"`
+	Currency   string `json:"currency"`
+	Capacity   int    `json:"capacity"`
+	Sold       int    `json:"sold"`
+	// The tier is on sale from SalesStart until SalesEnd; nil means no limit
+	SalesStart *time.Time `json:"sales_start"`
+	SalesEnd   *time.Time `json:"sales_end"`
+	// MaxPerOrder caps the tickets of one booking; 0 means no cap
+	MaxPerOrder int       `json:"max_per_order"`
+	Position    int       `json:"position"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// OnSale reports whether the tier's sale window includes at.
+func (t TicketTier) OnSale(at time.Time) bool {
+	return (t.SalesStart == nil || !at.Before(*t.SalesStart)) && (t.SalesEnd == nil || at.Before(*t.SalesEnd))
+}
+
 type Admin struct {
-	AdminID    string    `json:"admin_id"`
+	AdminID   string    `json:"admin_id"`
 	FirstName string    `json:"first_name"`
 	LastName  string    `json:"last_name"`
 	Username  string    `json:"username"`
-	Password  string    `json:"password"`
+	Password  string    `json:"-"`
 	Phone     string    `json:"phone"`
 	Email     string    `json:"email"`
 	CreatedAt time.Time `json:"created_at"`
-}
\ No newline at end of file
+}
+
+// Event lifecycle states
+const (
+	EventDraft     = "draft"
+	EventPublished = "published"
+	EventCancelled = "cancelled"
+	EventCompleted = "completed"
+)
+
+// eventTransitions lists the states each state may move to. Cancelled and
+// completed are final.
+var eventTransitions = map[string][]string{
+	EventDraft:     {EventPublished, EventCancelled},
+	EventPublished: {EventCancelled, EventCompleted},
+}
+
+// CanTransition reports whether an event may move from one state to another.
+func CanTransition(from, to string) bool {
+	for _, next := range eventTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+type Booking struct {
+	BookingID    string     `json:"booking_id"`
+	EventID      string     `json:"event_id"`
+	UserID       string     `json:"user_id"`
+	Status       string     `json:"status"`
+	CreatedAt    time.Time  `json:"created_at"`
+	CancelledAt  *time.Time `json:"cancelled_at"`
+	CancelReason string     `json:"cancel_reason"`
+	CheckedInAt  *time.Time `json:"checked_in_at"`
+	// TierID is empty for events without ticket tiers. The tier's name and
+	// price are copied at booking time.
+	TierID    string `json:"tier_id"`
+	TierName  string `json:"tier_name"`
+	Quantity  int    `json:"quantity"`
+	UnitPrice int64  `json:"unit_price"`
+	Currency  string `json:"currency"`
+	// ExpiresAt is set while the booking waits for payment
+	ExpiresAt *time.Time `json:"expires_at"`
+	Event     Event      `json:"event"`
+}
+
+// Attendee is a booking as the event's organizer sees it.
+type Attendee struct {
+	BookingID string    `json:"booking_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Email     string    `json:"email"`
+	Status    string    `json:"status"`
+	BookedAt  time.Time `json:"booked_at"`
+	TierName  string    `json:"tier_name"`
+	Quantity  int       `json:"quantity"`
+	// CheckedInAt is set when staff scan the attendee in at the door
+	CheckedInAt *time.Time `json:"checked_in_at"`
+}
+
+// EventMember gives a user a role on someone else's event. The event's
+// creator is always an owner without needing a row.
+type EventMember struct {
+	EventID   string    `json:"event_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	InvitedBy string    `json:"invited_by"`
+	InvitedAt time.Time `json:"invited_at"`
+	// AcceptedAt is nil while the invitation is pending
+	AcceptedAt *time.Time `json:"accepted_at"`
+}
+
+// Event member roles
+const (
+	MemberOwner        = "owner"
+	MemberCoOrganizer  = "co_organizer"
+	MemberCheckInStaff = "checkin_staff"
+	MemberViewer       = "viewer"
+)
+
+// Organization is a client organisation whose events are kept apart from
+// everyone else's.
+type Organization struct {
+	OrgID string `json:"org_id"`
+	Slug  string `json:"slug"`
+	Name  string `json:"name"`
+	// Branding shown on the organization's pages
+	LogoURL      string `json:"logo_url"`
+	PrimaryColor string `json:"primary_color"`
+	SupportEmail string `json:"support_email"`
+	// MembersOnly hides the organization's events from non-members
+	MembersOnly bool `json:"members_only"`
+	// MaxEventSlots caps total_slots of its events; 0 means no cap
+	MaxEventSlots int       `json:"max_event_slots"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// OrganizationUpdate holds the organization fields to change; nil fields are
+// left as they are.
+type OrganizationUpdate struct {
+	Name          *string
+	LogoURL       *string
+	PrimaryColor  *string
+	SupportEmail  *string
+	MembersOnly   *bool
+	MaxEventSlots *int
+}
+
+// OrgMember gives a user a role in an organization.
+type OrgMember struct {
+	OrgID    string    `json:"org_id"`
+	UserID   string    `json:"user_id"`
+	Username string    `json:"username"`
+	Email    string    `json:"email"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// Organization member roles
+const (
+	OrgRoleAdmin     = "admin"
+	OrgRoleOrganizer = "organizer"
+	OrgRoleMember    = "member"
+)
+
+// DefaultOrgID is the organization everything created before organizations
+// existed belongs to. Requests that don't name an organization act in it.
+const DefaultOrgID = "00000000-0000-0000-0000-000000000001"
+
+// Booking statuses. Paid bookings wait in pending_payment, holding their
+// slots, until the payment succeeds or the hold expires.
+const (
+	BookingPendingPayment = "pending_payment"
+	BookingConfirmed      = "confirmed"
+	BookingCancelled      = "cancelled"
+	BookingExpired        = "expired"
+)
+
+// HoldsSlots reports whether a booking in this status counts against the
+// event's and tier's capacity.
+func HoldsSlots(status string) bool {
+	return status == BookingConfirmed || status == BookingPendingPayment
+}
+
+// Payment statuses
+const (
+	PaymentPending   = "pending"
+	PaymentSucceeded = "succeeded"
+	PaymentFailed    = "failed"
+	PaymentCancelled = "cancelled"
+	// The booking was called off after the money was taken; it is returned
+	// by jobs.RefundPayments
+	PaymentRefundPending = "refund_pending"
+	PaymentRefunded      = "refunded"
+)
+
+// PaidFor reports whether the customer was charged for a payment in this
+// status, so its record has to be kept.
+func PaidFor(status string) bool {
+	return status == PaymentSucceeded || status == PaymentRefundPending || status == PaymentRefunded
+}
+
+// Payment is the charge for a paid booking, taken by an external payment
+// provider. IntentID is the provider's reference for it.
+type Payment struct {
+	PaymentID string    `json:"payment_id"`
+	BookingID string    `json:"booking_id"`
+	Provider  string    `json:"provider"`
+	IntentID  string    `json:"intent_id"`
+	Amount    int64     `json:"amount"`
+	Currency  string    `json:"currency"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// RetryAt is set once cancelling or refunding the payment failed
+	RetryAt *time.Time `json:"retry_at,omitempty"`
+}
+
+// SeatHold keeps tickets aside for a user while they check out. Its quantity
+// counts against the event's and tier's availability until it expires, is
+// released, or is booked.
+type SeatHold struct {
+	HoldID    string    `json:"hold_id"`
+	EventID   string    `json:"event_id"`
+	TierID    string    `json:"tier_id"`
+	UserID    string    `json:"user_id"`
+	Quantity  int       `json:"quantity"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type RefreshToken struct {
+	TokenID    string     `json:"token_id"`
+	FamilyID   string     `json:"family_id"`
+	SubjectID  string     `json:"subject_id"`
+	Role       string     `json:"role"`
+	TokenHash  string     `json:"-"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	ReplacedBy *string    `json:"replaced_by"`
+}
+
+// Purposes of single-use user tokens
+const (
+	PurposePasswordReset     = "password_reset"
+	PurposeEmailVerification = "email_verification"
+)
+
+// UserToken is a single-use token mailed to a user, such as a password reset
+// link. Only its hash is stored.
+type UserToken struct {
+	TokenID   string     `json:"token_id"`
+	UserID    string     `json:"user_id"`
+	Purpose   string     `json:"purpose"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+}
+
+// MFAFactor is the TOTP authenticator of a user or admin. It only guards
+// logins once ConfirmedAt is set.
+type MFAFactor struct {
+	SubjectID    string     `json:"subject_id"`
+	Secret       string     `json:"-"`
+	ConfirmedAt  *time.Time `json:"confirmed_at"`
+	LastUsedStep int64      `json:"-"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// OIDCLoginState is a sign-in with an external provider waiting for the
+// user to come back. It is found by the hash of the state parameter and
+// holds the PKCE verifier and nonce the provider's answer must match.
+type OIDCLoginState struct {
+	StateHash    string    `json:"-"`
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"-"`
+	Nonce        string    `json:"-"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// UserIdentity links an account at an external provider to a user.
+type UserIdentity struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	UserID    string    `json:"user_id"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// LoginThrottle counts recent failed sign-ins for one key, either an account
+// or a client IP.
+type LoginThrottle struct {
+	Key           string     `json:"key"`
+	Failures      int        `json:"failures"`
+	LockedUntil   *time.Time `json:"locked_until"`
+	LastFailureAt time.Time  `json:"last_failure_at"`
+}
+
+// APIKey lets an integration call the API as the organizer or admin who
+// created it, limited to its scopes. Only the hash of the key is stored;
+// Prefix is kept so owners can tell their keys apart.
+type APIKey struct {
+	KeyID      string     `json:"key_id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	KeyHash    string     `json:"-"`
+	Scopes     []string   `json:"scopes"`
+	OwnerID    string     `json:"owner_id"`
+	OwnerRole  string     `json:"owner_role"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+// ProfileUpdate lists the profile fields to change; nil fields are left as is.
+type ProfileUpdate struct {
+	FirstName *string
+	LastName  *string
+	Phone     *string
+}
+
+// UserFilter describes an admin ListUsers query. Search matches username or
+// email; zero times leave the created_at range open.
+type UserFilter struct {
+	Search      string
+	Role        string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+	Offset      int
+}
+
+// EventUpdate lists the event fields to change; nil fields are left as is.
+type EventUpdate struct {
+	Title       *string
+	Description *string
+	Location    *string
+	Date        *string
+	StartTime   *string
+	EndTime     *string
+	TotalSlots  *int
+}
+
+// ChangesSchedule reports whether the update moves the event's date or times.
+func (u EventUpdate) ChangesSchedule() bool {
+	return u.Date != nil || u.StartTime != nil || u.EndTime != nil
+}
+
+// Schedule returns the event's date, start and end once the update is
+// applied on top of event, as wall-clock times like Event's own.
+func (u EventUpdate) Schedule(event Event) (date, start, end time.Time, err error) {
+	date = event.Event_Date
+	startAt := event.Event_Start_Time.Sub(event.Event_Date)
+	endAt := event.Event_End_Time.Sub(event.Event_Date)
+	if u.Date != nil {
+		if date, err = time.Parse("2006-01-02", *u.Date); err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, fmt.Errorf("invalid event date %q", *u.Date)
+		}
+	}
+	if u.StartTime != nil {
+		if startAt, err = timeOfDay(*u.StartTime); err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, err
+		}
+	}
+	if u.EndTime != nil {
+		if endAt, err = timeOfDay(*u.EndTime); err != nil {
+			return time.Time{}, time.Time{}, time.Time{}, err
+		}
+	}
+	return date, date.Add(startAt), date.Add(endAt), nil
+}
+
+// timeOfDay parses a "15:04" or "15:04:05" time as a duration since midnight.
+func timeOfDay(clock string) (time.Duration, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time %q", clock)
+}
+
+// EventFilter describes a ListEvents query. Empty Statuses matches every
+// state. When Cursor is set the query continues after it (keyset pagination)
+// and Offset is ignored.
+type EventFilter struct {
+	// OrgID is required; events of other organizations never match
+	OrgID     string
+	Statuses  []string
+	StartDate string
+	EndDate   string
+	Location  string
+	CreatedBy string
+	// MemberID matches events the user created or has joined as a member
+	MemberID     string
+	HasFreeSlots bool
+	SortBy       string
+	Descending   bool
+	Limit        int
+	Offset       int
+	Cursor       *EventCursor
+}
+
+// EventCursor identifies the last event of a page: the value of the sort
+// column and the event ID as a tie-breaker. SortBy and Descending record the
+// order the cursor was taken in, since it means nothing in any other.
+type EventCursor struct {
+	SortValue  string `json:"v"`
+	EventID    string `json:"id"`
+	SortBy     string `json:"s"`
+	Descending bool   `json:"d,omitempty"`
+}
+
+// Fixed-width so cursor values of timestamp sort keys also order as strings
+const cursorTimeLayout = "2006-01-02T15:04:05.000000"
+
+// Cursor builds the keyset cursor pointing just past the event for the given
+// sort key.
+func (e Event) Cursor(sortBy string) EventCursor {
+	var value string
+	switch sortBy {
+	case "created_at":
+		value = e.CreatedAt.Format(cursorTimeLayout)
+	case "title":
+		value = e.Event_Title
+	default:
+		value = e.Event_Start_Time.Format(cursorTimeLayout)
+	}
+	return EventCursor{SortValue: value, EventID: e.Event_ID, SortBy: sortBy}
+}
@@ -0,0 +1,41 @@
+package oidc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ProvidersFromEnv reads the providers named in OIDC_PROVIDERS, a comma
+// separated list. Each one needs OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID
+// and OIDC_<NAME>_REDIRECT_URL; OIDC_<NAME>_CLIENT_SECRET and
+// OIDC_<NAME>_SCOPES (space separated) are optional. Without OIDC_PROVIDERS
+// external sign-in is off.
+func ProvidersFromEnv() (map[string]*Provider, error) {
+	providers := map[string]*Provider{}
+	raw := os.Getenv("OIDC_PROVIDERS")
+	if raw == "" {
+		return providers, nil
+	}
+
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		config := Config{
+			Name:         name,
+			Issuer:       os.Getenv(prefix + "ISSUER"),
+			ClientID:     os.Getenv(prefix + "CLIENT_ID"),
+			ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+			RedirectURL:  os.Getenv(prefix + "REDIRECT_URL"),
+			Scopes:       strings.Fields(os.Getenv(prefix + "SCOPES")),
+		}
+		if config.Issuer == "" || config.ClientID == "" || config.RedirectURL == "" {
+			return nil, fmt.Errorf("%sISSUER, %sCLIENT_ID and %sREDIRECT_URL are required", prefix, prefix, prefix)
+		}
+		providers[name] = NewProvider(config, nil)
+	}
+	return providers, nil
+}
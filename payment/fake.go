@@ -0,0 +1,208 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeSignatureHeader carries the signature of the fake processor's webhooks.
+const FakeSignatureHeader = "Fake-Signature"
+
+// Fake is an in-process card processor for development and tests. Intents
+// are paid by posting to its checkout endpoint (see ServeHTTP), after which
+// it sends a signed webhook to the service like a real processor would.
+type Fake struct {
+	webhookURL string
+	secret     string
+	client     *http.Client
+
+	mu      sync.Mutex
+	intents map[string]*fakeIntent
+	// Intent IDs by idempotency key
+	keys map[string]string
+}
+
+type fakeIntent struct {
+	clientSecret string
+	amount       int64
+	currency     string
+	status       string
+}
+
+func NewFake(webhookURL string, client *http.Client) *Fake {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Fake{
+		webhookURL: webhookURL,
+		secret:     randomHex(32),
+		client:     client,
+		intents:    map[string]*fakeIntent{},
+		keys:       map[string]string{},
+	}
+}
+
+func (f *Fake) Name() string {
+	return "fake"
+}
+
+func (f *Fake) CreateIntent(ctx context.Context, req IntentRequest) (Intent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if id, ok := f.keys[req.IdempotencyKey]; ok && req.IdempotencyKey != "" {
+		return Intent{ID: id, ClientSecret: f.intents[id].clientSecret}, nil
+	}
+	id := "pi_fake_" + randomHex(12)
+	intent := &fakeIntent{
+		clientSecret: id + "_secret_" + randomHex(12),
+		amount:       req.Amount,
+		currency:     req.Currency,
+		status:       "requires_payment_method",
+	}
+	f.intents[id] = intent
+	if req.IdempotencyKey != "" {
+		f.keys[req.IdempotencyKey] = id
+	}
+	return Intent{ID: id, ClientSecret: intent.clientSecret}, nil
+}
+
+func (f *Fake) CancelIntent(ctx context.Context, intentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Intents do not survive a restart, and ones we no longer know of can
+	// not be paid either
+	intent, ok := f.intents[intentID]
+	if !ok {
+		return nil
+	}
+	if intent.status == "succeeded" {
+		return errors.New("intent has already been paid")
+	}
+	intent.status = "canceled"
+	return nil
+}
+
+func (f *Fake) Refund(ctx context.Context, intentID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Intents do not survive a restart; there is nothing left to refund
+	intent, ok := f.intents[intentID]
+	if !ok {
+		return nil
+	}
+	switch intent.status {
+	case "succeeded":
+		intent.status = "refunded"
+	case "refunded":
+	default:
+		return fmt.Errorf("intent is %s, not paid", intent.status)
+	}
+	return nil
+}
+
+func (f *Fake) ParseWebhook(payload []byte, header http.Header) (Event, error) {
+	if err := verifySignature(f.secret, payload, header.Get(FakeSignatureHeader), time.Now()); err != nil {
+		return Event{}, err
+	}
+	return parseEvent(payload)
+}
+
+// ServeHTTP stands in for the processor's checkout: POST
+// /{intent_id}/pay with {"client_secret": ..., "outcome": "succeeded" or
+// "failed"} settles the intent and sends the webhook in the background.
+func (f *Fake) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	intentID, action, _ := strings.Cut(strings.Trim(r.URL.Path, "/"), "/")
+	if r.Method != http.MethodPost || action != "pay" {
+		http.NotFound(w, r)
+		return
+	}
+	var body struct {
+		ClientSecret string `json:"client_secret"`
+		Outcome      string `json:"outcome"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+	if body.Outcome == "" {
+		body.Outcome = "succeeded"
+	}
+	if body.Outcome != "succeeded" && body.Outcome != "failed" {
+		http.Error(w, `outcome must be "succeeded" or "failed"`, http.StatusBadRequest)
+		return
+	}
+
+	f.mu.Lock()
+	intent, ok := f.intents[intentID]
+	switch {
+	case !ok || subtle.ConstantTimeCompare([]byte(intent.clientSecret), []byte(body.ClientSecret)) != 1:
+		f.mu.Unlock()
+		http.Error(w, "no such payment", http.StatusNotFound)
+		return
+	case intent.status != "requires_payment_method":
+		f.mu.Unlock()
+		http.Error(w, "payment is "+intent.status, http.StatusConflict)
+		return
+	}
+	eventType := "payment_intent.payment_failed"
+	if body.Outcome == "succeeded" {
+		intent.status = "succeeded"
+		eventType = "payment_intent.succeeded"
+	}
+	f.mu.Unlock()
+
+	go f.deliver(intentID, eventType)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string]string{"id": intentID, "status": body.Outcome})
+}
+
+// deliver posts a signed webhook, retrying a few times like real processors.
+func (f *Fake) deliver(intentID, eventType string) {
+	var event webhookEvent
+	event.ID = "evt_fake_" + randomHex(12)
+	event.Type = eventType
+	event.Data.Object.ID = intentID
+	payload, _ := json.Marshal(event)
+
+	for attempt, delay := 1, time.Second; attempt <= 3; attempt, delay = attempt+1, delay*2 {
+		req, err := http.NewRequest(http.MethodPost, f.webhookURL, bytes.NewReader(payload))
+		if err != nil {
+			log.Printf("Fake payment webhook: %v", err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(FakeSignatureHeader, sign(f.secret, payload, time.Now()))
+		resp, err := f.client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode < 300 {
+				return
+			}
+			err = fmt.Errorf("status %d", resp.StatusCode)
+		}
+		log.Printf("Fake payment webhook attempt %d failed: %v", attempt, err)
+		time.Sleep(delay)
+	}
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,92 @@
+// Package payment charges customers for paid bookings through a pluggable
+// card processor. Payments are started here, but a booking only counts as
+// paid once the processor says so in a signed webhook.
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// IntentRequest asks the processor to prepare a charge.
+type IntentRequest struct {
+	// Amount is in the currency's minor unit
+	Amount      int64
+	Currency    string
+	Description string
+	// Requests with the same key return the same intent, so retries never
+	// charge twice
+	IdempotencyKey string
+	Metadata       map[string]string
+}
+
+// Intent is a charge waiting for the customer to pay it.
+type Intent struct {
+	ID string
+	// ClientSecret lets the customer's browser complete the payment with the
+	// processor directly
+	ClientSecret string
+}
+
+// EventType is what a webhook reports about an intent.
+type EventType string
+
+const (
+	EventSucceeded EventType = "succeeded"
+	EventFailed    EventType = "failed"
+)
+
+// Event is a verified webhook. Type is empty for notifications this service
+// does not act on; they should be acknowledged and ignored.
+type Event struct {
+	ID       string
+	Type     EventType
+	IntentID string
+}
+
+// Provider is a card processor. Implementations must be safe for concurrent
+// use.
+type Provider interface {
+	Name() string
+	CreateIntent(ctx context.Context, req IntentRequest) (Intent, error)
+	// CancelIntent makes an unpaid intent unpayable. It fails if the payment
+	// already went through.
+	CancelIntent(ctx context.Context, intentID string) error
+	// Refund returns the whole amount of a paid intent. Refunding an intent
+	// again is a no-op.
+	Refund(ctx context.Context, intentID string) error
+	// ParseWebhook checks the signature of a webhook request and decodes it,
+	// failing with ErrInvalidSignature for forged or stale requests.
+	ParseWebhook(payload []byte, header http.Header) (Event, error)
+}
+
+// NewFromEnv picks the processor from PAYMENT_PROVIDER:
+//
+//   - "stripe" uses STRIPE_SECRET_KEY and STRIPE_WEBHOOK_SECRET, and
+//     STRIPE_API_URL if set
+//   - "fake" (the default) settles payments in-process for development; it
+//     delivers its webhooks to FAKE_PAYMENT_WEBHOOK_URL (default
+//     http://localhost:8080/webhooks/payments/fake)
+func NewFromEnv() (Provider, error) {
+	switch provider := os.Getenv("PAYMENT_PROVIDER"); provider {
+	case "stripe":
+		key, secret := os.Getenv("STRIPE_SECRET_KEY"), os.Getenv("STRIPE_WEBHOOK_SECRET")
+		if key == "" || secret == "" {
+			return nil, fmt.Errorf("STRIPE_SECRET_KEY and STRIPE_WEBHOOK_SECRET are required")
+		}
+		return NewStripe(key, secret, os.Getenv("STRIPE_API_URL"), nil), nil
+	case "fake", "":
+		webhookURL := os.Getenv("FAKE_PAYMENT_WEBHOOK_URL")
+		if webhookURL == "" {
+			webhookURL = "http://localhost:8080/webhooks/payments/fake"
+		}
+		return NewFake(webhookURL, nil), nil
+	default:
+		return nil, fmt.Errorf("unknown PAYMENT_PROVIDER %q", provider)
+	}
+}
@@ -0,0 +1,121 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const stripeAPIURL = "https://api.stripe.com"
+
+// Stripe takes payments with Stripe PaymentIntents. Customers pay in the
+// browser with Stripe.js using the intent's client secret.
+type Stripe struct {
+	apiURL        string
+	secretKey     string
+	webhookSecret string
+	client        *http.Client
+}
+
+func NewStripe(secretKey, webhookSecret, apiURL string, client *http.Client) *Stripe {
+	if apiURL == "" {
+		apiURL = stripeAPIURL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Stripe{apiURL: strings.TrimRight(apiURL, "/"), secretKey: secretKey, webhookSecret: webhookSecret, client: client}
+}
+
+func (s *Stripe) Name() string {
+	return "stripe"
+}
+
+func (s *Stripe) CreateIntent(ctx context.Context, req IntentRequest) (Intent, error) {
+	form := url.Values{
+		"amount":                             {strconv.FormatInt(req.Amount, 10)},
+		"currency":                           {strings.ToLower(req.Currency)},
+		"automatic_payment_methods[enabled]": {"true"},
+	}
+	if req.Description != "" {
+		form.Set("description", req.Description)
+	}
+	for key, value := range req.Metadata {
+		form.Set("metadata["+key+"]", value)
+	}
+
+	var intent struct {
+		ID           string `json:"id"`
+		ClientSecret string `json:"client_secret"`
+	}
+	if err := s.post(ctx, "/v1/payment_intents", form, req.IdempotencyKey, &intent); err != nil {
+		return Intent{}, err
+	}
+	return Intent{ID: intent.ID, ClientSecret: intent.ClientSecret}, nil
+}
+
+func (s *Stripe) CancelIntent(ctx context.Context, intentID string) error {
+	return s.post(ctx, "/v1/payment_intents/"+url.PathEscape(intentID)+"/cancel", url.Values{}, "", nil)
+}
+
+func (s *Stripe) Refund(ctx context.Context, intentID string) error {
+	form := url.Values{"payment_intent": {intentID}}
+	return s.post(ctx, "/v1/refunds", form, "refund-"+intentID, nil)
+}
+
+func (s *Stripe) ParseWebhook(payload []byte, header http.Header) (Event, error) {
+	if err := verifySignature(s.webhookSecret, payload, header.Get("Stripe-Signature"), time.Now()); err != nil {
+		return Event{}, err
+	}
+	return parseEvent(payload)
+}
+
+// post sends a form-encoded API request and decodes the JSON response into
+// out, if given.
+func (s *Stripe) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.apiURL+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.secretKey)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("stripe %s: %w", path, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Code    string `json:"code"`
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(body, &apiErr) == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("stripe %s: %s (%s)", path, apiErr.Error.Message, apiErr.Error.Code)
+		}
+		return fmt.Errorf("stripe %s: status %d", path, resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("stripe %s: decode response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *UserRepo) CreateAdmin(ctx context.Context, adminID, email, password, firstName, lastName, username, phone string) error {
+	query := `INSERT INTO admins (admin_id, first_name, last_name, username, password, phone, email, created_at) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())`
+
+	if _, err := r.db.Exec(ctx, query, adminID, firstName, lastName, username, password, phone, email); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *UserRepo) GetAdminById(ctx context.Context, adminID string) (model.Admin, error) {
+	return r.getAdmin(ctx, `admin_id = $1`, adminID)
+}
+
+func (r *UserRepo) GetAdminByUsername(ctx context.Context, username string) (model.Admin, error) {
+	return r.getAdmin(ctx, `username = $1`, username)
+}
+
+func (r *UserRepo) getAdmin(ctx context.Context, where string, arg string) (model.Admin, error) {
+	var admin model.Admin
+	query := `SELECT admin_id, first_name, last_name, username, password, phone, email, created_at 
+			  FROM admins WHERE ` + where
+
+	if err := r.db.QueryRow(ctx, query, arg).Scan(
+		&admin.AdminID,
+		&admin.FirstName,
+		&admin.LastName,
+		&admin.Username,
+		&admin.Password,
+		&admin.Phone,
+		&admin.Email,
+		&admin.CreatedAt,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Admin{}, status.Errorf(codes.NotFound, "admin not found")
+		}
+		return model.Admin{}, err
+	}
+	return admin, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyRepo is the Postgres implementation of the API key store.
+type APIKeyRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAPIKeyRepo(db *pgxpool.Pool) *APIKeyRepo {
+	return &APIKeyRepo{db: db}
+}
+
+const apiKeyColumns = `key_id, name, prefix, key_hash, scopes, owner_id, owner_role, created_at, last_used_at, revoked_at`
+
+func (r *APIKeyRepo) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	query := `INSERT INTO api_keys (key_id, name, prefix, key_hash, scopes, owner_id, owner_role, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.db.Exec(ctx, query, key.KeyID, key.Name, key.Prefix, key.KeyHash, key.Scopes, key.OwnerID, key.OwnerRole, key.CreatedAt)
+	if err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepo) GetAPIKey(ctx context.Context, keyID string) (model.APIKey, error) {
+	return r.getAPIKey(ctx, `key_id = $1`, keyID)
+}
+
+func (r *APIKeyRepo) GetAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error) {
+	return r.getAPIKey(ctx, `key_hash = $1`, keyHash)
+}
+
+func (r *APIKeyRepo) getAPIKey(ctx context.Context, cond string, arg string) (model.APIKey, error) {
+	key, err := scanAPIKey(r.db.QueryRow(ctx, `SELECT `+apiKeyColumns+` FROM api_keys WHERE `+cond, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.APIKey{}, status.Errorf(codes.NotFound, "API key not found")
+		}
+		return model.APIKey{}, err
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepo) ListAPIKeys(ctx context.Context, ownerID string, includeRevoked bool) ([]model.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM api_keys
+			  WHERE ($1 = '' OR owner_id = $1) AND ($2 OR revoked_at IS NULL)
+			  ORDER BY created_at DESC, key_id`
+	rows, err := r.db.Query(ctx, query, ownerID, includeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []model.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (r *APIKeyRepo) RevokeAPIKey(ctx context.Context, keyID string, at time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE api_keys SET revoked_at = $2 WHERE key_id = $1 AND revoked_at IS NULL`, keyID, at)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		// Tell a missing key apart from one that was already revoked
+		if _, err := r.GetAPIKey(ctx, keyID); err != nil {
+			return err
+		}
+		return status.Errorf(codes.FailedPrecondition, "API key is already revoked")
+	}
+	return nil
+}
+
+func (r *APIKeyRepo) TouchAPIKey(ctx context.Context, keyID string, at time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE api_keys SET last_used_at = $2 WHERE key_id = $1`, keyID, at)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "API key not found")
+	}
+	return nil
+}
+
+func scanAPIKey(row scanner) (model.APIKey, error) {
+	var key model.APIKey
+	err := row.Scan(
+		&key.KeyID,
+		&key.Name,
+		&key.Prefix,
+		&key.KeyHash,
+		&key.Scopes,
+		&key.OwnerID,
+		&key.OwnerRole,
+		&key.CreatedAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+	)
+	return key, err
+}
@@ -0,0 +1,426 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BookingRepo is the Postgres implementation of the booking repository.
+type BookingRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewBookingRepo(db *pgxpool.Pool) *BookingRepo {
+	return &BookingRepo{db: db}
+}
+
+const bookingColumns = `b.booking_id, b.event_id, b.user_id, b.status, b.created_at, b.cancelled_at, COALESCE(b.cancel_reason, ''), b.checked_in_at,
+	COALESCE(b.tier_id, ''), b.tier_name, b.quantity, b.unit_price_minor, b.currency, b.expires_at,
+	e.event_title, COALESCE(e.event_description, ''), e.event_location, e.event_date,
+	e.event_date + e.event_start_time, e.event_date + e.event_end_time, e.org_id`
+
+// CreateBooking reserves quantity slots of a published event and of the
+// chosen tier, and records the booking.
+func (r *BookingRepo) CreateBooking(ctx context.Context, orgID, bookingID, eventID, userID, tierID string, quantity int, expiresAt *time.Time) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := reserveSlots(ctx, tx, orgID, eventID, tierID, quantity); err != nil {
+		return err
+	}
+	if err := insertBooking(ctx, tx, bookingID, eventID, userID, tierID, quantity, expiresAt); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// BookSeatHold deletes the hold and books its slots in one transaction. The
+// DELETE locks the hold, so it is booked, released or swept exactly once.
+func (r *BookingRepo) BookSeatHold(ctx context.Context, orgID, bookingID, holdID string, now time.Time, expiresAt *time.Time) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var eventID, tierID, userID, eventStatus string
+	var quantity int
+	var holdExpiresAt time.Time
+	err = tx.QueryRow(ctx, `DELETE FROM seat_holds h USING events e
+		WHERE h.hold_id = $1 AND e.event_id = h.event_id AND e.org_id = $2
+		RETURNING h.event_id, COALESCE(h.tier_id, ''), h.user_id, h.quantity, h.expires_at, e.status`,
+		holdID, orgID).Scan(&eventID, &tierID, &userID, &quantity, &holdExpiresAt, &eventStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "seat hold not found")
+		}
+		return err
+	}
+	// Leave expired holds and their slots to the sweeper
+	if !holdExpiresAt.After(now) {
+		return status.Errorf(codes.FailedPrecondition, "seat hold has expired")
+	}
+	if eventStatus != model.EventPublished {
+		return status.Errorf(codes.FailedPrecondition, "event is %s", eventStatus)
+	}
+
+	if err := insertBooking(ctx, tx, bookingID, eventID, userID, tierID, quantity, expiresAt); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// reserveSlots takes quantity slots of a published event and, unless tierID
+// is empty, of that tier. The conditional UPDATE takes a row lock on the
+// event, so concurrent bookings and holds are serialised and neither
+// booked_slots nor a tier's sold count can pass its capacity.
+func reserveSlots(ctx context.Context, tx pgx.Tx, orgID, eventID, tierID string, quantity int) error {
+	tag, err := tx.Exec(ctx, `UPDATE events SET booked_slots = booked_slots + $4
+		WHERE event_id = $1 AND org_id = $3 AND status = $2 AND booked_slots + $4 <= total_slots`, eventID, model.EventPublished, orgID, quantity)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		var eventStatus string
+		var left int
+		err := tx.QueryRow(ctx, `SELECT status, total_slots - booked_slots FROM events WHERE event_id = $1 AND org_id = $2`, eventID, orgID).Scan(&eventStatus, &left)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.NotFound, "event not found")
+			}
+			return err
+		}
+		switch {
+		case eventStatus == model.EventDraft:
+			return status.Errorf(codes.NotFound, "event not found")
+		case eventStatus == model.EventPublished && left <= 0:
+			return status.Errorf(codes.FailedPrecondition, "event is fully booked")
+		case eventStatus == model.EventPublished:
+			return status.Errorf(codes.FailedPrecondition, "not enough slots left for this event (%d available)", left)
+		}
+		return status.Errorf(codes.FailedPrecondition, "event is %s", eventStatus)
+	}
+
+	if tierID == "" {
+		return nil
+	}
+	tag, err = tx.Exec(ctx, `UPDATE ticket_tiers SET sold = sold + $3
+		WHERE tier_id = $1 AND event_id = $2 AND sold + $3 <= capacity`, tierID, eventID, quantity)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return tierUnavailable(ctx, tx, eventID, tierID)
+	}
+	return nil
+}
+
+// insertBooking records a booking of slots already reserved, copying the
+// tier's name and price onto it.
+func insertBooking(ctx context.Context, tx pgx.Tx, bookingID, eventID, userID, tierID string, quantity int, expiresAt *time.Time) error {
+	bookingStatus := model.BookingConfirmed
+	if expiresAt != nil {
+		bookingStatus = model.BookingPendingPayment
+	}
+	query := `INSERT INTO bookings (booking_id, event_id, user_id, status, created_at, tier_id, tier_name, quantity, unit_price_minor, currency, expires_at)
+			  SELECT $1, $2, $3, $4, NOW(), t.tier_id, COALESCE(t.name, ''), $6::integer, COALESCE(t.price_minor, 0), COALESCE(t.currency, ''), $7::timestamp
+			  FROM (SELECT 1) one LEFT JOIN ticket_tiers t ON t.tier_id = $5`
+	_, err := tx.Exec(ctx, query, bookingID, eventID, userID, bookingStatus, tierID, quantity, expiresAt)
+	return err
+}
+
+// tierUnavailable explains why the tier could not take the booking.
+func tierUnavailable(ctx context.Context, tx pgx.Tx, eventID, tierID string) error {
+	var name string
+	var left int
+	err := tx.QueryRow(ctx, `SELECT name, capacity - sold FROM ticket_tiers WHERE tier_id = $1 AND event_id = $2`, tierID, eventID).Scan(&name, &left)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "ticket tier not found")
+		}
+		return err
+	}
+	if left <= 0 {
+		return status.Errorf(codes.FailedPrecondition, "%s tickets are sold out", name)
+	}
+	return status.Errorf(codes.FailedPrecondition, "not enough %s tickets left (%d available)", name, left)
+}
+
+func (r *BookingRepo) GetBooking(ctx context.Context, orgID, bookingID string) (model.Booking, error) {
+	query := `SELECT ` + bookingColumns + ` FROM bookings b JOIN events e ON e.event_id = b.event_id
+			  WHERE b.booking_id = $1 AND e.org_id = $2`
+	booking, err := scanBooking(r.db.QueryRow(ctx, query, bookingID, orgID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Booking{}, status.Errorf(codes.NotFound, "booking not found")
+		}
+		return model.Booking{}, err
+	}
+	return booking, nil
+}
+
+// ListBookingsByUser returns a page of the user's bookings, newest first, and
+// the total number of bookings matching bookingStatus ("" for any).
+func (r *BookingRepo) ListBookingsByUser(ctx context.Context, orgID, userID, bookingStatus string, limit, offset int) ([]model.Booking, int, error) {
+	where := ` WHERE b.user_id = $1 AND e.org_id = $2`
+	args := []any{userID, orgID}
+	if bookingStatus != "" {
+		args = append(args, bookingStatus)
+		where += fmt.Sprintf(" AND b.status = $%d", len(args))
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM bookings b JOIN events e ON e.event_id = b.event_id`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + bookingColumns + ` FROM bookings b JOIN events e ON e.event_id = b.event_id` + where +
+		fmt.Sprintf(" ORDER BY b.created_at DESC, b.booking_id LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var bookings []model.Booking
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		bookings = append(bookings, booking)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return bookings, total, nil
+}
+
+// ListAttendees returns one page of an event's bookings with their holders.
+func (r *BookingRepo) ListAttendees(ctx context.Context, orgID, eventID, bookingStatus string, limit, offset int) ([]model.Attendee, int, error) {
+	where := ` WHERE b.event_id = $1 AND e.org_id = $2`
+	args := []any{eventID, orgID}
+	if bookingStatus != "" {
+		args = append(args, bookingStatus)
+		where += fmt.Sprintf(" AND b.status = $%d", len(args))
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM bookings b JOIN events e ON e.event_id = b.event_id`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT b.booking_id, b.user_id, u.username, u.first_name, u.last_name, u.email, b.status, b.created_at, b.tier_name, b.quantity, b.checked_in_at
+			  FROM bookings b JOIN events e ON e.event_id = b.event_id JOIN users u ON u.user_id = b.user_id` + where +
+		fmt.Sprintf(" ORDER BY b.created_at, b.booking_id LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var attendees []model.Attendee
+	for rows.Next() {
+		var a model.Attendee
+		if err := rows.Scan(&a.BookingID, &a.UserID, &a.Username, &a.FirstName, &a.LastName, &a.Email, &a.Status, &a.BookedAt, &a.TierName, &a.Quantity, &a.CheckedInAt); err != nil {
+			return nil, 0, err
+		}
+		attendees = append(attendees, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return attendees, total, nil
+}
+
+// CancelBooking marks a confirmed or unpaid booking as cancelled and returns
+// its slots to the event in the same transaction.
+func (r *BookingRepo) CancelBooking(ctx context.Context, orgID, bookingID string) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Payment webhooks lock the payment before the booking; do the same
+	var paymentStatus string
+	err = tx.QueryRow(ctx, `SELECT status FROM payments WHERE booking_id = $1 FOR UPDATE`, bookingID).Scan(&paymentStatus)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	if model.PaidFor(paymentStatus) {
+		return status.Errorf(codes.FailedPrecondition, "paid bookings cannot be cancelled; contact the organizer")
+	}
+
+	var eventID, tierID string
+	var quantity int
+	err = tx.QueryRow(ctx, `UPDATE bookings SET status = $2, cancelled_at = NOW(), expires_at = NULL
+		WHERE booking_id = $1 AND status IN ($3, $4) AND event_id IN (SELECT event_id FROM events WHERE org_id = $5)
+		RETURNING event_id, COALESCE(tier_id, ''), quantity`,
+		bookingID, model.BookingCancelled, model.BookingConfirmed, model.BookingPendingPayment, orgID).Scan(&eventID, &tierID, &quantity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.FailedPrecondition, "booking is not active")
+		}
+		return err
+	}
+
+	if err := releaseSlots(ctx, tx, bookingID, eventID, tierID, quantity); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// ExpireBooking releases a booking whose payment hold ran out.
+func (r *BookingRepo) ExpireBooking(ctx context.Context, bookingID string, now time.Time) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM payments WHERE booking_id = $1 FOR UPDATE`, bookingID); err != nil {
+		return err
+	}
+
+	var eventID, tierID string
+	var quantity int
+	err = tx.QueryRow(ctx, `UPDATE bookings SET status = $2, cancelled_at = $4, expires_at = NULL
+		WHERE booking_id = $1 AND status = $3 AND expires_at < $4
+		RETURNING event_id, COALESCE(tier_id, ''), quantity`,
+		bookingID, model.BookingExpired, model.BookingPendingPayment, now).Scan(&eventID, &tierID, &quantity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.FailedPrecondition, "booking is not awaiting payment")
+		}
+		return err
+	}
+
+	if err := releaseSlots(ctx, tx, bookingID, eventID, tierID, quantity); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *BookingRepo) ListAbandonedBookings(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT b.booking_id FROM bookings b
+		WHERE b.status = $1 AND b.expires_at < $2 AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.booking_id = b.booking_id)
+		ORDER BY b.expires_at LIMIT $3`, model.BookingPendingPayment, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// releaseSlots gives a booking's slots back to its event and tier and
+// cancels its payment if it was never taken.
+func releaseSlots(ctx context.Context, tx pgx.Tx, bookingID, eventID, tierID string, quantity int) error {
+	if err := returnSlots(ctx, tx, eventID, tierID, quantity); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `UPDATE payments SET status = $2, updated_at = NOW()
+		WHERE booking_id = $1 AND status IN ($3, $4)`,
+		bookingID, model.PaymentCancelled, model.PaymentPending, model.PaymentFailed)
+	return err
+}
+
+// returnSlots puts quantity slots back into the event's and tier's
+// inventory.
+func returnSlots(ctx context.Context, tx pgx.Tx, eventID, tierID string, quantity int) error {
+	if _, err := tx.Exec(ctx, `UPDATE events SET booked_slots = GREATEST(booked_slots - $2, 0)
+		WHERE event_id = $1`, eventID, quantity); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, `UPDATE ticket_tiers SET sold = GREATEST(sold - $2, 0)
+		WHERE tier_id = $1`, tierID, quantity)
+	return err
+}
+
+func (r *BookingRepo) CheckInBooking(ctx context.Context, orgID, bookingID string, at time.Time) (model.Booking, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE bookings SET checked_in_at = $2
+		WHERE booking_id = $1 AND status = $3 AND checked_in_at IS NULL
+		AND event_id IN (SELECT event_id FROM events WHERE org_id = $4)`,
+		bookingID, at, model.BookingConfirmed, orgID)
+	if err != nil {
+		return model.Booking{}, translateError(err)
+	}
+
+	booking, err := r.GetBooking(ctx, orgID, bookingID)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	if tag.RowsAffected() == 0 {
+		// Tell the staff member why the scan was refused
+		if booking.Status != model.BookingConfirmed {
+			return model.Booking{}, status.Errorf(codes.FailedPrecondition, "booking is not active")
+		}
+		return model.Booking{}, status.Errorf(codes.FailedPrecondition, "booking was already checked in at %s", booking.CheckedInAt.Format(time.RFC3339))
+	}
+	return booking, nil
+}
+
+func scanBooking(row scanner) (model.Booking, error) {
+	var booking model.Booking
+	var createdAt *time.Time
+	if err := row.Scan(
+		&booking.BookingID,
+		&booking.EventID,
+		&booking.UserID,
+		&booking.Status,
+		&createdAt,
+		&booking.CancelledAt,
+		&booking.CancelReason,
+		&booking.CheckedInAt,
+		&booking.TierID,
+		&booking.TierName,
+		&booking.Quantity,
+		&booking.UnitPrice,
+		&booking.Currency,
+		&booking.ExpiresAt,
+		&booking.Event.Event_Title,
+		&booking.Event.Event_Description,
+		&booking.Event.Event_Location,
+		&booking.Event.Event_Date,
+		&booking.Event.Event_Start_Time,
+		&booking.Event.Event_End_Time,
+		&booking.Event.OrgID,
+	); err != nil {
+		return model.Booking{}, err
+	}
+	if createdAt != nil {
+		booking.CreatedAt = *createdAt
+	}
+	booking.Event.Event_ID = booking.EventID
+	return booking, nil
+}
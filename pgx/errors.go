@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// SQLSTATE codes translated by translateError.
+const (
+	notNullViolation     = "23502"
+	foreignKeyViolation  = "23503"
+	uniqueViolation      = "23505"
+	checkViolation       = "23514"
+	invalidTextFormat    = "22P02"
+	invalidDatetime      = "22007"
+	datetimeOutOfRange   = "22008"
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// errorDomain identifies this service in ErrorInfo details.
+const errorDomain = "eventpass"
+
+// Postgres reports the offending key as `Key (username)=(alice) already exists.`
+var keyDetailPattern = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// translateError turns Postgres errors into gRPC statuses carrying the
+// violated constraint and column, so a duplicate username reads as
+// AlreadyExists rather than a server failure. Any other error, including
+// statuses built by the repositories, is returned unchanged.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	field := pgErr.ColumnName
+	if m := keyDetailPattern.FindStringSubmatch(pgErr.Detail); m != nil {
+		field = m[1]
+	}
+	info := &errdetails.ErrorInfo{
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"table":      pgErr.TableName,
+			"constraint": pgErr.ConstraintName,
+		},
+	}
+	if field != "" {
+		info.Metadata["field"] = field
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation:
+		info.Reason = "UNIQUE_VIOLATION"
+		return withDetails(codes.AlreadyExists, describe(field, "already exists"), info,
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: "is already taken"},
+			}})
+	case foreignKeyViolation:
+		info.Reason = "FOREIGN_KEY_VIOLATION"
+		return withDetails(codes.FailedPrecondition, describe(field, "references a missing or still referenced row"), info,
+			&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: "FOREIGN_KEY", Subject: pgErr.TableName, Description: pgErr.Detail},
+			}})
+	case checkViolation:
+		info.Reason = "CHECK_VIOLATION"
+		return withDetails(codes.FailedPrecondition, "constraint "+pgErr.ConstraintName+" violated", info,
+			&errdetails.PreconditionFailure{Violations: []*errdetails.PreconditionFailure_Violation{
+				{Type: "CHECK", Subject: pgErr.TableName, Description: pgErr.ConstraintName},
+			}})
+	case notNullViolation:
+		info.Reason = "NOT_NULL_VIOLATION"
+		return withDetails(codes.InvalidArgument, describe(field, "is required"), info,
+			&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: "is required"},
+			}})
+	case invalidTextFormat, invalidDatetime, datetimeOutOfRange:
+		info.Reason = "INVALID_VALUE"
+		return withDetails(codes.InvalidArgument, "invalid value: "+pgErr.Message, info)
+	case serializationFailure, deadlockDetected:
+		info.Reason = "CONCURRENT_UPDATE"
+		return withDetails(codes.Aborted, "conflicting concurrent update, please retry", info)
+	}
+	return err
+}
+
+func describe(field, problem string) string {
+	if field == "" {
+		return "value " + problem
+	}
+	return field + " " + problem
+}
+
+func withDetails(code codes.Code, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	if detailed, err := st.WithDetails(details...); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
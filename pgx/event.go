@@ -2,31 +2,396 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"eventpass/model"
-	"eventpass/utils"
+	"eventpass/validate"
+	"fmt"
+	"strings"
+	"time"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func NewEventRepo(db *pgxpool.Pool) *PostgresRepo {
-	return &PostgresRepo{db: db}
+// eventColumns selects start and end as full timestamps (date + time) since
+// pgx cannot scan a bare TIME column into time.Time.
+const eventColumns = `event_id, event_title, COALESCE(event_description, ''), event_location, event_date,
+	event_date + event_start_time, event_date + event_end_time, created_by, total_slots, booked_slots, version, status, COALESCE(cancel_reason, ''), created_at, org_id`
+
+// Sort keys accepted by ListEvents, mapped to the SQL expression used for
+// ordering and for keyset comparisons.
+var eventSortColumns = map[string]struct {
+	expr string
+	cast string
+}{
+	"date":       {"event_date + event_start_time", "timestamp"},
+	"created_at": {"created_at", "timestamp"},
+	"title":      {"event_title", "text"},
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// EventRepo is the Postgres implementation of the event repository.
+type EventRepo struct {
+	db *pgxpool.Pool
 }
 
-func CreateEvent(ctx context.Context, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy string, totalSlots int32) error {
-	query := `INSERT INTO events (event_id, event_title, event_description, event_location, event_date, event_start_time, event_end_time,created_by, total_slots) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
-	if _, err := utils.DB.Exec(ctx, query, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy, totalSlots); err != nil {
+func NewEventRepo(db *pgxpool.Pool) *EventRepo {
+	return &EventRepo{db: db}
+}
+
+func (r *EventRepo) CreateEvent(ctx context.Context, orgID, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy string, totalSlots int32, tiers []model.TicketTier) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
 		return err
 	}
+	defer tx.Rollback(ctx)
+
+	// The creator is either a user or an admin; the check constraint rejects
+	// IDs that are neither
+	query := `INSERT INTO events (event_id, event_title, event_description, event_location, event_date, event_start_time, event_end_time,
+			  created_by, created_by_user, created_by_admin, total_slots, org_id)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8,
+			  (SELECT user_id FROM users WHERE user_id = $8), (SELECT admin_id FROM admins WHERE admin_id = $8), $9, $10)`
+	if _, err := tx.Exec(ctx, query, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy, totalSlots, orgID); err != nil {
+		return err
+	}
+	if err := insertTicketTiers(ctx, tx, eventID, tiers); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *EventRepo) GetEvent(ctx context.Context, orgID, eventID string) (model.Event, error) {
+	query := `SELECT ` + eventColumns + ` FROM events WHERE event_id = $1 AND org_id = $2`
+	event, err := scanEvent(r.db.QueryRow(ctx, query, eventID, orgID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, status.Errorf(codes.NotFound, "event not found")
+		}
+		return model.Event{}, err
+	}
+	return event, nil
+}
+
+// ListEvents returns one page of events matching filter together with the
+// total number of matching events (ignoring pagination).
+func (r *EventRepo) ListEvents(ctx context.Context, filter model.EventFilter) ([]model.Event, int, error) {
+	sort, ok := eventSortColumns[filter.SortBy]
+	if !ok {
+		sort = eventSortColumns["date"]
+	}
+
+	var conds []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	// Always scoped to one organization, even when the filter is empty
+	conds = append(conds, "org_id = "+arg(filter.OrgID))
+	if filter.StartDate != "" {
+		conds = append(conds, "event_date >= "+arg(filter.StartDate)+"::date")
+	}
+	if filter.EndDate != "" {
+		conds = append(conds, "event_date <= "+arg(filter.EndDate)+"::date")
+	}
+	if filter.Location != "" {
+		conds = append(conds, "event_location ILIKE "+arg("%"+filter.Location+"%"))
+	}
+	if filter.CreatedBy != "" {
+		conds = append(conds, "created_by = "+arg(filter.CreatedBy))
+	}
+	if filter.MemberID != "" {
+		member := arg(filter.MemberID)
+		conds = append(conds, "(created_by = "+member+" OR event_id IN (SELECT event_id FROM event_members WHERE user_id = "+member+" AND accepted_at IS NOT NULL))")
+	}
+	if len(filter.Statuses) > 0 {
+		conds = append(conds, "status = ANY("+arg(filter.Statuses)+")")
+	}
+	if filter.HasFreeSlots {
+		conds = append(conds, "booked_slots < total_slots")
+	}
+
+	where := " WHERE " + strings.Join(conds, " AND ")
+
+	// Total ignores the cursor so it stays stable while paging
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM events`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	order, cmp := "ASC", ">"
+	if filter.Descending {
+		order, cmp = "DESC", "<"
+	}
+	if filter.Cursor != nil {
+		where += fmt.Sprintf(" AND (%s, event_id) %s (%s::%s, %s)",
+			sort.expr, cmp, arg(filter.Cursor.SortValue), sort.cast, arg(filter.Cursor.EventID))
+	}
+
+	query := `SELECT ` + eventColumns + ` FROM events` + where +
+		fmt.Sprintf(" ORDER BY %s %s, event_id %s LIMIT %s", sort.expr, order, order, arg(filter.Limit))
+	if filter.Cursor == nil && filter.Offset > 0 {
+		query += " OFFSET " + arg(filter.Offset)
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var events []model.Event
+	for rows.Next() {
+		event, err := scanEvent(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (r *EventRepo) UpdateEvent(ctx context.Context, orgID, eventID string, version int, update model.EventUpdate) (_ model.Event, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return model.Event{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the row so bookings cannot change booked_slots under us
+	current, err := scanEvent(tx.QueryRow(ctx, `SELECT `+eventColumns+` FROM events WHERE event_id = $1 AND org_id = $2 FOR UPDATE`, eventID, orgID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Event{}, status.Errorf(codes.NotFound, "event not found")
+		}
+		return model.Event{}, err
+	}
+	if current.Version != version {
+		return model.Event{}, status.Errorf(codes.Aborted, "event was modified (now at version %d)", current.Version)
+	}
+	if current.Status == model.EventCancelled || current.Status == model.EventCompleted {
+		return model.Event{}, status.Errorf(codes.FailedPrecondition, "%s events cannot be changed", current.Status)
+	}
+	if update.TotalSlots != nil && *update.TotalSlots < current.BookedSlots {
+		return model.Event{}, status.Errorf(codes.FailedPrecondition, "total_slots cannot be less than the %d slots already booked", current.BookedSlots)
+	}
+	if err := checkSchedule(current, update); err != nil {
+		return model.Event{}, err
+	}
+
+	var sets []string
+	var args []any
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if update.Title != nil {
+		set("event_title", *update.Title)
+	}
+	if update.Description != nil {
+		set("event_description", *update.Description)
+	}
+	if update.Location != nil {
+		set("event_location", *update.Location)
+	}
+	if update.Date != nil {
+		set("event_date", *update.Date)
+	}
+	if update.StartTime != nil {
+		set("event_start_time", *update.StartTime)
+	}
+	if update.EndTime != nil {
+		set("event_end_time", *update.EndTime)
+	}
+	if update.TotalSlots != nil {
+		set("total_slots", *update.TotalSlots)
+	}
+	sets = append(sets, "version = version + 1")
+
+	args = append(args, eventID)
+	query := fmt.Sprintf(`UPDATE events SET %s WHERE event_id = $%d RETURNING %s`,
+		strings.Join(sets, ", "), len(args), eventColumns)
+	event, err := scanEvent(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		return model.Event{}, err
+	}
+	return event, tx.Commit(ctx)
+}
+
+// checkSchedule checks the event's schedule as the update would leave it, as
+// the request alone may carry only some of the date and times.
+func checkSchedule(event model.Event, update model.EventUpdate) error {
+	if !update.ChangesSchedule() {
+		return nil
+	}
+	_, start, end, err := update.Schedule(event)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	var v validate.Violations
+	validate.Schedule(&v, "event.", start, end, update.Date != nil || update.StartTime != nil)
+	return v.Err()
+}
+
+func (r *EventRepo) DeleteEvent(ctx context.Context, orgID, eventID string, force bool) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var bookedSlots int
+	err = tx.QueryRow(ctx, `SELECT booked_slots FROM events WHERE event_id = $1 AND org_id = $2 FOR UPDATE`, eventID, orgID).Scan(&bookedSlots)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "event not found")
+		}
+		return err
+	}
+	if bookedSlots > 0 && !force {
+		return status.Errorf(codes.FailedPrecondition, "event has %d active bookings", bookedSlots)
+	}
+	var paid bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM payments p JOIN bookings b ON b.booking_id = p.booking_id
+		WHERE b.event_id = $1 AND p.status IN ($2, $3, $4))`,
+		eventID, model.PaymentSucceeded, model.PaymentRefundPending, model.PaymentRefunded).Scan(&paid)
+	if err != nil {
+		return err
+	}
+	if paid {
+		return status.Errorf(codes.FailedPrecondition, "events with paid bookings cannot be deleted")
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM bookings WHERE event_id = $1`, eventID); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM events WHERE event_id = $1`, eventID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// PublishEvent makes a draft event visible and bookable.
+func (r *EventRepo) PublishEvent(ctx context.Context, orgID, eventID string) (_ model.Event, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return model.Event{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockEventForTransition(ctx, tx, orgID, eventID, model.EventPublished); err != nil {
+		return model.Event{}, err
+	}
+	query := `UPDATE events SET status = $2, version = version + 1 WHERE event_id = $1 RETURNING ` + eventColumns
+	event, err := scanEvent(tx.QueryRow(ctx, query, eventID, model.EventPublished))
+	if err != nil {
+		return model.Event{}, err
+	}
+	return event, tx.Commit(ctx)
+}
+
+// CancelEvent cancels the event and every confirmed booking of it, recording
+// reason on both. It returns the number of bookings cancelled.
+func (r *EventRepo) CancelEvent(ctx context.Context, orgID, eventID, reason string) (_ model.Event, _ int, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return model.Event{}, 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockEventForTransition(ctx, tx, orgID, eventID, model.EventCancelled); err != nil {
+		return model.Event{}, 0, err
+	}
+
+	// Unpaid bookings go too, and with them their payments; paid ones are
+	// refunded
+	if _, err := tx.Exec(ctx, `UPDATE payments SET status = $2, updated_at = NOW()
+		WHERE status IN ($3, $4) AND booking_id IN (SELECT booking_id FROM bookings WHERE event_id = $1 AND status = $5)`,
+		eventID, model.PaymentCancelled, model.PaymentPending, model.PaymentFailed, model.BookingPendingPayment); err != nil {
+		return model.Event{}, 0, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE payments SET status = $2, updated_at = NOW()
+		WHERE status = $3 AND booking_id IN (SELECT booking_id FROM bookings WHERE event_id = $1 AND status = $4)`,
+		eventID, model.PaymentRefundPending, model.PaymentSucceeded, model.BookingConfirmed); err != nil {
+		return model.Event{}, 0, err
+	}
+	tag, err := tx.Exec(ctx, `UPDATE bookings SET status = $2, cancelled_at = NOW(), cancel_reason = $3, expires_at = NULL
+		WHERE event_id = $1 AND status IN ($4, $5)`,
+		eventID, model.BookingCancelled, reason, model.BookingConfirmed, model.BookingPendingPayment)
+	if err != nil {
+		return model.Event{}, 0, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM seat_holds WHERE event_id = $1`, eventID); err != nil {
+		return model.Event{}, 0, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE ticket_tiers SET sold = 0 WHERE event_id = $1`, eventID); err != nil {
+		return model.Event{}, 0, err
+	}
+
+	query := `UPDATE events SET status = $2, cancel_reason = $3, booked_slots = 0, version = version + 1
+		WHERE event_id = $1 RETURNING ` + eventColumns
+	event, err := scanEvent(tx.QueryRow(ctx, query, eventID, model.EventCancelled, reason))
+	if err != nil {
+		return model.Event{}, 0, err
+	}
+	return event, int(tag.RowsAffected()), tx.Commit(ctx)
+}
+
+// CompleteFinishedEvents marks published events whose end time is before now
+// as completed and returns how many were updated.
+func (r *EventRepo) CompleteFinishedEvents(ctx context.Context, now time.Time) (int, error) {
+	tag, err := r.db.Exec(ctx, `UPDATE events SET status = $1, version = version + 1
+		WHERE status = $2 AND event_date + event_end_time < $3`,
+		model.EventCompleted, model.EventPublished, now)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// lockEventForTransition locks the organization's event row and checks that
+// it may move to the target state. Later statements in tx can then address
+// the event by ID alone.
+func lockEventForTransition(ctx context.Context, tx pgx.Tx, orgID, eventID, to string) error {
+	var current string
+	err := tx.QueryRow(ctx, `SELECT status FROM events WHERE event_id = $1 AND org_id = $2 FOR UPDATE`, eventID, orgID).Scan(&current)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.NotFound, "event not found")
+		}
+		return err
+	}
+	if !model.CanTransition(current, to) {
+		return status.Errorf(codes.FailedPrecondition, "cannot move event from %s to %s", current, to)
+	}
 	return nil
 }
 
-func GetEvent(ctx context.Context, eventID string) (model.Event, error) {
+func scanEvent(row scanner) (model.Event, error) {
 	var event model.Event
-	query := `SELECT event_id, event_title, event_description, event_location, event_date, event_start_time, event_end_time, created_by, total_slots FROM events WHERE event_id = $1`
-	if err := utils.DB.QueryRow(ctx, query, eventID).Scan(
+	var createdAt *time.Time
+	if err := row.Scan(
 		&event.Event_ID,
 		&event.Event_Title,
 		&event.Event_Description,
@@ -36,11 +401,17 @@ func GetEvent(ctx context.Context, eventID string) (model.Event, error) {
 		&event.Event_End_Time,
 		&event.CreatedBy,
 		&event.TotalSlots,
+		&event.BookedSlots,
+		&event.Version,
+		&event.Status,
+		&event.CancelReason,
+		&createdAt,
+		&event.OrgID,
 	); err != nil {
-		if err == pgx.ErrNoRows {
-			return model.Event{}, status.Errorf(codes.NotFound, "event not found")
-		}
 		return model.Event{}, err
 	}
+	if createdAt != nil {
+		event.CreatedAt = *createdAt
+	}
 	return event, nil
 }
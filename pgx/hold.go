@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SeatHoldRepo is the Postgres implementation of the seat hold store.
+type SeatHoldRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSeatHoldRepo(db *pgxpool.Pool) *SeatHoldRepo {
+	return &SeatHoldRepo{db: db}
+}
+
+const holdColumns = `h.hold_id, h.event_id, COALESCE(h.tier_id, ''), h.user_id, h.quantity, h.expires_at, h.created_at`
+
+func (r *SeatHoldRepo) CreateSeatHold(ctx context.Context, orgID string, hold model.SeatHold) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Make way for the new hold if the user's last one ran out
+	var existing string
+	var expiresAt time.Time
+	err = tx.QueryRow(ctx, `SELECT hold_id, expires_at FROM seat_holds WHERE event_id = $1 AND user_id = $2`,
+		hold.EventID, hold.UserID).Scan(&existing, &expiresAt)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return err
+	case expiresAt.After(hold.CreatedAt):
+		return status.Errorf(codes.AlreadyExists, "you already hold seats for this event; extend or release that hold")
+	default:
+		if _, err := releaseHold(ctx, tx, existing, &hold.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := reserveSlots(ctx, tx, orgID, hold.EventID, hold.TierID, hold.Quantity); err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `INSERT INTO seat_holds (hold_id, event_id, tier_id, user_id, quantity, expires_at, created_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7)`,
+		hold.HoldID, hold.EventID, hold.TierID, hold.UserID, hold.Quantity, hold.ExpiresAt, hold.CreatedAt)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *SeatHoldRepo) GetSeatHold(ctx context.Context, orgID, holdID string) (model.SeatHold, error) {
+	query := `SELECT ` + holdColumns + ` FROM seat_holds h JOIN events e ON e.event_id = h.event_id
+			  WHERE h.hold_id = $1 AND e.org_id = $2`
+	hold, err := scanSeatHold(r.db.QueryRow(ctx, query, holdID, orgID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.SeatHold{}, status.Errorf(codes.NotFound, "seat hold not found")
+		}
+		return model.SeatHold{}, err
+	}
+	return hold, nil
+}
+
+func (r *SeatHoldRepo) ExtendSeatHold(ctx context.Context, orgID, holdID string, expiresAt, now time.Time) (model.SeatHold, error) {
+	query := `UPDATE seat_holds h SET expires_at = $3
+			  FROM events e
+			  WHERE h.hold_id = $1 AND e.event_id = h.event_id AND e.org_id = $2 AND h.expires_at > $4
+			  RETURNING ` + holdColumns
+	hold, err := scanSeatHold(r.db.QueryRow(ctx, query, holdID, orgID, expiresAt, now))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Tell a missing hold from one that ran out
+			if _, err := r.GetSeatHold(ctx, orgID, holdID); err != nil {
+				return model.SeatHold{}, err
+			}
+			return model.SeatHold{}, status.Errorf(codes.FailedPrecondition, "seat hold has expired")
+		}
+		return model.SeatHold{}, translateError(err)
+	}
+	return hold, nil
+}
+
+func (r *SeatHoldRepo) ReleaseSeatHold(ctx context.Context, orgID, holdID string) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var inOrg bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM seat_holds h JOIN events e ON e.event_id = h.event_id
+		WHERE h.hold_id = $1 AND e.org_id = $2)`, holdID, orgID).Scan(&inOrg)
+	if err != nil {
+		return err
+	}
+	released := false
+	if inOrg {
+		if released, err = releaseHold(ctx, tx, holdID, nil); err != nil {
+			return err
+		}
+	}
+	if !released {
+		return status.Errorf(codes.NotFound, "seat hold not found")
+	}
+	return tx.Commit(ctx)
+}
+
+// ReleaseExpiredHolds releases each hold in its own transaction, so a busy
+// event only waits on one hold at a time and a failure does not undo the
+// others.
+func (r *SeatHoldRepo) ReleaseExpiredHolds(ctx context.Context, now time.Time, limit int) (int, error) {
+	rows, err := r.db.Query(ctx, `SELECT hold_id FROM seat_holds WHERE expires_at <= $1 ORDER BY expires_at LIMIT $2`, now, limit)
+	if err != nil {
+		return 0, err
+	}
+	var holdIDs []string
+	for rows.Next() {
+		var holdID string
+		if err := rows.Scan(&holdID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		holdIDs = append(holdIDs, holdID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, holdID := range holdIDs {
+		released, err := r.releaseExpiredHold(ctx, holdID, now)
+		if err != nil {
+			return n, err
+		}
+		if released {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *SeatHoldRepo) releaseExpiredHold(ctx context.Context, holdID string, now time.Time) (_ bool, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	// The hold may have been extended, booked or released since it was listed
+	released, err := releaseHold(ctx, tx, holdID, &now)
+	if err != nil {
+		return false, err
+	}
+	return released, tx.Commit(ctx)
+}
+
+// releaseHold deletes a hold and returns its slots, only if it expired by
+// expiredBy when that is set. It reports whether there was a hold to release.
+// Deleting first locks the hold, so a concurrent booking of it either wins or
+// finds it gone.
+func releaseHold(ctx context.Context, tx pgx.Tx, holdID string, expiredBy *time.Time) (bool, error) {
+	var eventID, tierID string
+	var quantity int
+	err := tx.QueryRow(ctx, `DELETE FROM seat_holds WHERE hold_id = $1 AND ($2::timestamp IS NULL OR expires_at <= $2)
+		RETURNING event_id, COALESCE(tier_id, ''), quantity`, holdID, expiredBy).Scan(&eventID, &tierID, &quantity)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, returnSlots(ctx, tx, eventID, tierID, quantity)
+}
+
+func scanSeatHold(row scanner) (model.SeatHold, error) {
+	var hold model.SeatHold
+	err := row.Scan(
+		&hold.HoldID,
+		&hold.EventID,
+		&hold.TierID,
+		&hold.UserID,
+		&hold.Quantity,
+		&hold.ExpiresAt,
+		&hold.CreatedAt,
+	)
+	return hold, err
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EventMemberRepo is the Postgres implementation of event memberships.
+type EventMemberRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewEventMemberRepo(db *pgxpool.Pool) *EventMemberRepo {
+	return &EventMemberRepo{db: db}
+}
+
+const memberColumns = `m.event_id, m.user_id, u.username, u.email, m.role, m.invited_by, m.invited_at, m.accepted_at`
+
+func (r *EventMemberRepo) AddEventMember(ctx context.Context, orgID string, member model.EventMember) error {
+	query := `INSERT INTO event_members (event_id, user_id, role, invited_by, invited_at)
+			  SELECT $1, $2, $3, $4, $5 FROM events WHERE event_id = $1 AND org_id = $6`
+	tag, err := r.db.Exec(ctx, query, member.EventID, member.UserID, member.Role, member.InvitedBy, member.InvitedAt, orgID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "event not found")
+	}
+	return nil
+}
+
+func (r *EventMemberRepo) GetEventMember(ctx context.Context, orgID, eventID, userID string) (model.EventMember, error) {
+	query := `SELECT ` + memberColumns + ` FROM event_members m JOIN users u ON u.user_id = m.user_id
+			  JOIN events e ON e.event_id = m.event_id
+			  WHERE m.event_id = $1 AND m.user_id = $2 AND e.org_id = $3`
+	member, err := scanMember(r.db.QueryRow(ctx, query, eventID, userID, orgID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.EventMember{}, status.Errorf(codes.NotFound, "member not found")
+		}
+		return model.EventMember{}, err
+	}
+	return member, nil
+}
+
+func (r *EventMemberRepo) AcceptEventMember(ctx context.Context, orgID, eventID, userID string, at time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE event_members SET accepted_at = $3
+			  WHERE event_id = $1 AND user_id = $2 AND accepted_at IS NULL
+			  AND event_id IN (SELECT event_id FROM events WHERE org_id = $4)`, eventID, userID, at, orgID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "no pending invitation")
+	}
+	return nil
+}
+
+func (r *EventMemberRepo) RemoveEventMember(ctx context.Context, orgID, eventID, userID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM event_members WHERE event_id = $1 AND user_id = $2
+			  AND event_id IN (SELECT event_id FROM events WHERE org_id = $3)`, eventID, userID, orgID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "member not found")
+	}
+	return nil
+}
+
+func (r *EventMemberRepo) ListEventMembers(ctx context.Context, orgID, eventID string) ([]model.EventMember, error) {
+	query := `SELECT ` + memberColumns + ` FROM event_members m JOIN users u ON u.user_id = m.user_id
+			  JOIN events e ON e.event_id = m.event_id
+			  WHERE m.event_id = $1 AND e.org_id = $2 ORDER BY m.invited_at, m.user_id`
+	rows, err := r.db.Query(ctx, query, eventID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []model.EventMember
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+func scanMember(row scanner) (model.EventMember, error) {
+	var member model.EventMember
+	err := row.Scan(
+		&member.EventID,
+		&member.UserID,
+		&member.Username,
+		&member.Email,
+		&member.Role,
+		&member.InvitedBy,
+		&member.InvitedAt,
+		&member.AcceptedAt,
+	)
+	return member, err
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MFARepo is the Postgres implementation of the TOTP factor store.
+type MFARepo struct {
+	db *pgxpool.Pool
+}
+
+func NewMFARepo(db *pgxpool.Pool) *MFARepo {
+	return &MFARepo{db: db}
+}
+
+func (r *MFARepo) GetMFAFactor(ctx context.Context, subjectID string) (model.MFAFactor, error) {
+	var factor model.MFAFactor
+	var createdAt *time.Time
+	err := r.db.QueryRow(ctx, `SELECT subject_id, secret, confirmed_at, last_used_step, created_at
+		FROM mfa_factors WHERE subject_id = $1`, subjectID).Scan(
+		&factor.SubjectID,
+		&factor.Secret,
+		&factor.ConfirmedAt,
+		&factor.LastUsedStep,
+		&createdAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.MFAFactor{}, status.Errorf(codes.NotFound, "mfa factor not found")
+		}
+		return model.MFAFactor{}, err
+	}
+	if createdAt != nil {
+		factor.CreatedAt = *createdAt
+	}
+	return factor, nil
+}
+
+func (r *MFARepo) SaveMFAFactor(ctx context.Context, factor model.MFAFactor) error {
+	// The upsert only overwrites a factor that was never confirmed
+	tag, err := r.db.Exec(ctx, `INSERT INTO mfa_factors (subject_id, secret, created_at) VALUES ($1, $2, NOW())
+		ON CONFLICT (subject_id) DO UPDATE SET secret = EXCLUDED.secret, last_used_step = 0, created_at = NOW()
+		WHERE mfa_factors.confirmed_at IS NULL`, factor.SubjectID, factor.Secret)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+	}
+	return nil
+}
+
+func (r *MFARepo) ConfirmMFAFactor(ctx context.Context, subjectID string, step int64, codeHashes []string) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE mfa_factors SET confirmed_at = NOW(), last_used_step = $2
+		WHERE subject_id = $1 AND confirmed_at IS NULL`, subjectID, step)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.FailedPrecondition, "no pending two-factor enrolment")
+	}
+
+	if err := replaceRecoveryCodes(ctx, tx, subjectID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *MFARepo) DeleteMFAFactor(ctx context.Context, subjectID string) error {
+	// Recovery codes go with it through ON DELETE CASCADE
+	tag, err := r.db.Exec(ctx, `DELETE FROM mfa_factors WHERE subject_id = $1`, subjectID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "mfa factor not found")
+	}
+	return nil
+}
+
+func (r *MFARepo) UseMFAStep(ctx context.Context, subjectID string, step int64) error {
+	tag, err := r.db.Exec(ctx, `UPDATE mfa_factors SET last_used_step = $2
+		WHERE subject_id = $1 AND last_used_step < $2`, subjectID, step)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.AlreadyExists, "code has already been used")
+	}
+	return nil
+}
+
+func (r *MFARepo) UseRecoveryCode(ctx context.Context, subjectID, codeHash string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE mfa_recovery_codes SET used_at = NOW()
+		WHERE subject_id = $1 AND code_hash = $2 AND used_at IS NULL`, subjectID, codeHash)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "recovery code not found")
+	}
+	return nil
+}
+
+func replaceRecoveryCodes(ctx context.Context, tx pgx.Tx, subjectID string, codeHashes []string) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE subject_id = $1`, subjectID); err != nil {
+		return err
+	}
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec(ctx, `INSERT INTO mfa_recovery_codes (code_id, subject_id, code_hash) VALUES ($1, $2, $3)`,
+			uuid.New().String(), subjectID, hash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
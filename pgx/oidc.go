@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OIDCRepo is the Postgres implementation of external sign-in attempts and
+// linked identities.
+type OIDCRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOIDCRepo(db *pgxpool.Pool) *OIDCRepo {
+	return &OIDCRepo{db: db}
+}
+
+// CreateOIDCLoginState compares expiry against the application clock, which
+// is also what wrote expires_at.
+func (r *OIDCRepo) CreateOIDCLoginState(ctx context.Context, state model.OIDCLoginState) error {
+	if _, err := r.db.Exec(ctx, `DELETE FROM oidc_login_states WHERE expires_at < $1`, time.Now().UTC()); err != nil {
+		return err
+	}
+	query := `INSERT INTO oidc_login_states (state_hash, provider, code_verifier, nonce, expires_at)
+			  VALUES ($1, $2, $3, $4, $5)`
+	if _, err := r.db.Exec(ctx, query, state.StateHash, state.Provider, state.CodeVerifier, state.Nonce, state.ExpiresAt); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *OIDCRepo) ConsumeOIDCLoginState(ctx context.Context, stateHash string) (model.OIDCLoginState, error) {
+	var state model.OIDCLoginState
+	err := r.db.QueryRow(ctx, `DELETE FROM oidc_login_states WHERE state_hash = $1
+		RETURNING state_hash, provider, code_verifier, nonce, expires_at`, stateHash).Scan(
+		&state.StateHash,
+		&state.Provider,
+		&state.CodeVerifier,
+		&state.Nonce,
+		&state.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OIDCLoginState{}, status.Errorf(codes.NotFound, "login state not found")
+		}
+		return model.OIDCLoginState{}, err
+	}
+	return state, nil
+}
+
+func (r *OIDCRepo) GetUserIdentity(ctx context.Context, provider, subject string) (model.UserIdentity, error) {
+	var identity model.UserIdentity
+	err := r.db.QueryRow(ctx, `SELECT provider, subject, user_id, email, created_at
+		FROM user_identities WHERE provider = $1 AND subject = $2`, provider, subject).Scan(
+		&identity.Provider,
+		&identity.Subject,
+		&identity.UserID,
+		&identity.Email,
+		&identity.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserIdentity{}, status.Errorf(codes.NotFound, "identity not found")
+		}
+		return model.UserIdentity{}, err
+	}
+	return identity, nil
+}
+
+func (r *OIDCRepo) CreateUserIdentity(ctx context.Context, identity model.UserIdentity) error {
+	query := `INSERT INTO user_identities (provider, subject, user_id, email, created_at)
+			  VALUES ($1, $2, $3, $4, $5)`
+	if _, err := r.db.Exec(ctx, query, identity.Provider, identity.Subject, identity.UserID, identity.Email, identity.CreatedAt); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrganizationRepo is the Postgres implementation of organizations and their
+// members.
+type OrganizationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOrganizationRepo(db *pgxpool.Pool) *OrganizationRepo {
+	return &OrganizationRepo{db: db}
+}
+
+const orgColumns = `o.org_id, o.slug, o.name, o.logo_url, o.primary_color, o.support_email, o.members_only, o.max_event_slots, o.created_at`
+
+const orgMemberColumns = `m.org_id, m.user_id, u.username, u.email, m.role, m.joined_at`
+
+func (r *OrganizationRepo) CreateOrganization(ctx context.Context, org model.Organization) error {
+	query := `INSERT INTO organizations (org_id, slug, name, logo_url, primary_color, support_email, members_only, max_event_slots, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := r.db.Exec(ctx, query, org.OrgID, org.Slug, org.Name, org.LogoURL, org.PrimaryColor, org.SupportEmail, org.MembersOnly, org.MaxEventSlots, org.CreatedAt)
+	if err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *OrganizationRepo) GetOrganization(ctx context.Context, orgID string) (model.Organization, error) {
+	return r.getOrganization(ctx, `o.org_id = $1`, orgID)
+}
+
+func (r *OrganizationRepo) GetOrganizationBySlug(ctx context.Context, slug string) (model.Organization, error) {
+	return r.getOrganization(ctx, `LOWER(o.slug) = LOWER($1)`, slug)
+}
+
+func (r *OrganizationRepo) getOrganization(ctx context.Context, cond string, arg string) (model.Organization, error) {
+	org, err := scanOrganization(r.db.QueryRow(ctx, `SELECT `+orgColumns+` FROM organizations o WHERE `+cond, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+		}
+		return model.Organization{}, err
+	}
+	return org, nil
+}
+
+func (r *OrganizationRepo) ListOrganizations(ctx context.Context, userID string) ([]model.Organization, error) {
+	query := `SELECT ` + orgColumns + ` FROM organizations o`
+	var args []any
+	if userID != "" {
+		query += ` JOIN organization_members m ON m.org_id = o.org_id WHERE m.user_id = $1`
+		args = append(args, userID)
+	}
+	rows, err := r.db.Query(ctx, query+` ORDER BY o.name, o.org_id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var orgs []model.Organization
+	for rows.Next() {
+		org, err := scanOrganization(rows)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, org)
+	}
+	return orgs, rows.Err()
+}
+
+func (r *OrganizationRepo) UpdateOrganization(ctx context.Context, orgID string, update model.OrganizationUpdate) (model.Organization, error) {
+	var sets []string
+	var args []any
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if update.Name != nil {
+		set("name", *update.Name)
+	}
+	if update.LogoURL != nil {
+		set("logo_url", *update.LogoURL)
+	}
+	if update.PrimaryColor != nil {
+		set("primary_color", *update.PrimaryColor)
+	}
+	if update.SupportEmail != nil {
+		set("support_email", *update.SupportEmail)
+	}
+	if update.MembersOnly != nil {
+		set("members_only", *update.MembersOnly)
+	}
+	if update.MaxEventSlots != nil {
+		set("max_event_slots", *update.MaxEventSlots)
+	}
+	if len(sets) == 0 {
+		return r.GetOrganization(ctx, orgID)
+	}
+
+	args = append(args, orgID)
+	query := fmt.Sprintf(`UPDATE organizations o SET %s WHERE o.org_id = $%d RETURNING %s`,
+		strings.Join(sets, ", "), len(args), orgColumns)
+	org, err := scanOrganization(r.db.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+		}
+		return model.Organization{}, translateError(err)
+	}
+	return org, nil
+}
+
+func (r *OrganizationRepo) AddOrgMember(ctx context.Context, member model.OrgMember) error {
+	query := `INSERT INTO organization_members (org_id, user_id, role, joined_at) VALUES ($1, $2, $3, $4)`
+	if _, err := r.db.Exec(ctx, query, member.OrgID, member.UserID, member.Role, member.JoinedAt); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *OrganizationRepo) GetOrgMember(ctx context.Context, orgID, userID string) (model.OrgMember, error) {
+	query := `SELECT ` + orgMemberColumns + ` FROM organization_members m JOIN users u ON u.user_id = m.user_id
+			  WHERE m.org_id = $1 AND m.user_id = $2`
+	member, err := scanOrgMember(r.db.QueryRow(ctx, query, orgID, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.OrgMember{}, status.Errorf(codes.NotFound, "member not found")
+		}
+		return model.OrgMember{}, err
+	}
+	return member, nil
+}
+
+func (r *OrganizationRepo) RemoveOrgMember(ctx context.Context, orgID, userID string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM organization_members WHERE org_id = $1 AND user_id = $2`, orgID, userID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "member not found")
+	}
+	return nil
+}
+
+func (r *OrganizationRepo) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	query := `SELECT ` + orgMemberColumns + ` FROM organization_members m JOIN users u ON u.user_id = m.user_id
+			  WHERE m.org_id = $1 ORDER BY m.joined_at, m.user_id`
+	rows, err := r.db.Query(ctx, query, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []model.OrgMember
+	for rows.Next() {
+		member, err := scanOrgMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, member)
+	}
+	return members, rows.Err()
+}
+
+func scanOrganization(row scanner) (model.Organization, error) {
+	var org model.Organization
+	err := row.Scan(
+		&org.OrgID,
+		&org.Slug,
+		&org.Name,
+		&org.LogoURL,
+		&org.PrimaryColor,
+		&org.SupportEmail,
+		&org.MembersOnly,
+		&org.MaxEventSlots,
+		&org.CreatedAt,
+	)
+	return org, err
+}
+
+func scanOrgMember(row scanner) (model.OrgMember, error) {
+	var member model.OrgMember
+	err := row.Scan(
+		&member.OrgID,
+		&member.UserID,
+		&member.Username,
+		&member.Email,
+		&member.Role,
+		&member.JoinedAt,
+	)
+	return member, err
+}
@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PaymentRepo is the Postgres implementation of the payment store.
+type PaymentRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentRepo(db *pgxpool.Pool) *PaymentRepo {
+	return &PaymentRepo{db: db}
+}
+
+const paymentColumns = `p.payment_id, p.booking_id, p.provider, p.intent_id, p.amount, p.currency, p.status, p.created_at, p.updated_at, p.retry_at`
+
+func (r *PaymentRepo) CreatePayment(ctx context.Context, payment model.Payment) error {
+	query := `INSERT INTO payments (payment_id, booking_id, provider, intent_id, amount, currency, status, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)`
+	_, err := r.db.Exec(ctx, query, payment.PaymentID, payment.BookingID, payment.Provider, payment.IntentID,
+		payment.Amount, payment.Currency, payment.Status, payment.CreatedAt)
+	if err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *PaymentRepo) GetPaymentByBooking(ctx context.Context, bookingID string) (model.Payment, error) {
+	payment, err := scanPayment(r.db.QueryRow(ctx, `SELECT `+paymentColumns+` FROM payments p WHERE p.booking_id = $1`, bookingID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Payment{}, status.Errorf(codes.NotFound, "payment not found")
+		}
+		return model.Payment{}, err
+	}
+	return payment, nil
+}
+
+// ApplyPaymentEvent records the webhook in the same transaction that applies
+// it, so a redelivery racing the original is either applied or skipped as a
+// whole.
+func (r *PaymentRepo) ApplyPaymentEvent(ctx context.Context, provider, eventID, intentID, paymentStatus string) (_ model.Booking, _ bool, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return model.Booking{}, false, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `INSERT INTO payment_webhook_events (provider, event_id) VALUES ($1, $2)
+		ON CONFLICT DO NOTHING`, provider, eventID)
+	if err != nil {
+		return model.Booking{}, false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.Booking{}, false, nil
+	}
+
+	var paymentID, bookingID string
+	err = tx.QueryRow(ctx, `SELECT payment_id, booking_id FROM payments WHERE provider = $1 AND intent_id = $2 FOR UPDATE`,
+		provider, intentID).Scan(&paymentID, &bookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.Booking{}, false, status.Errorf(codes.NotFound, "payment not found")
+		}
+		return model.Booking{}, false, err
+	}
+
+	switch paymentStatus {
+	case model.PaymentSucceeded:
+		// The money was taken even if the booking was called off meanwhile,
+		// in which case it goes back
+		var bookingStatus string
+		if err := tx.QueryRow(ctx, `SELECT status FROM bookings WHERE booking_id = $1 FOR UPDATE`, bookingID).Scan(&bookingStatus); err != nil {
+			return model.Booking{}, false, err
+		}
+		settled := model.PaymentSucceeded
+		switch bookingStatus {
+		case model.BookingPendingPayment:
+			if _, err := tx.Exec(ctx, `UPDATE bookings SET status = $2, expires_at = NULL WHERE booking_id = $1`,
+				bookingID, model.BookingConfirmed); err != nil {
+				return model.Booking{}, false, err
+			}
+		case model.BookingConfirmed:
+		default:
+			settled = model.PaymentRefundPending
+		}
+		if _, err := tx.Exec(ctx, `UPDATE payments SET status = $2, updated_at = NOW() WHERE payment_id = $1 AND status IN ($3, $4, $5)`,
+			paymentID, settled, model.PaymentPending, model.PaymentFailed, model.PaymentCancelled); err != nil {
+			return model.Booking{}, false, err
+		}
+	case model.PaymentFailed:
+		if _, err := tx.Exec(ctx, `UPDATE payments SET status = $2, updated_at = NOW() WHERE payment_id = $1 AND status = $3`,
+			paymentID, model.PaymentFailed, model.PaymentPending); err != nil {
+			return model.Booking{}, false, err
+		}
+	}
+
+	query := `SELECT ` + bookingColumns + ` FROM bookings b JOIN events e ON e.event_id = b.event_id WHERE b.booking_id = $1`
+	booking, err := scanBooking(tx.QueryRow(ctx, query, bookingID))
+	if err != nil {
+		return model.Booking{}, false, err
+	}
+	return booking, true, tx.Commit(ctx)
+}
+
+func (r *PaymentRepo) ListExpiredPayments(ctx context.Context, now time.Time, limit int) ([]model.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments p JOIN bookings b ON b.booking_id = p.booking_id
+			  WHERE b.status = $1 AND b.expires_at < $2 AND (p.retry_at IS NULL OR p.retry_at <= $2)
+			  ORDER BY b.expires_at LIMIT $3`
+	rows, err := r.db.Query(ctx, query, model.BookingPendingPayment, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []model.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (r *PaymentRepo) ListPendingRefunds(ctx context.Context, now time.Time, limit int) ([]model.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments p
+			  WHERE p.status = $1 AND (p.retry_at IS NULL OR p.retry_at <= $2) ORDER BY p.updated_at LIMIT $3`
+	rows, err := r.db.Query(ctx, query, model.PaymentRefundPending, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []model.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
+
+func (r *PaymentRepo) MarkPaymentRefunded(ctx context.Context, paymentID string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE payments SET status = $2, retry_at = NULL, updated_at = NOW() WHERE payment_id = $1 AND status = $3`,
+		paymentID, model.PaymentRefunded, model.PaymentRefundPending)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.FailedPrecondition, "payment is not awaiting a refund")
+	}
+	return nil
+}
+
+func (r *PaymentRepo) DeferPayment(ctx context.Context, paymentID string, retryAt time.Time) error {
+	tag, err := r.db.Exec(ctx, `UPDATE payments SET retry_at = $2 WHERE payment_id = $1`, paymentID, retryAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "payment not found")
+	}
+	return nil
+}
+
+func (r *PaymentRepo) ListOpenPayments(ctx context.Context, eventID, userID string) ([]model.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments p JOIN bookings b ON b.booking_id = p.booking_id
+			  WHERE p.status IN ($1, $2) AND b.status = $3 AND ($4 = '' OR b.event_id = $4) AND ($5 = '' OR b.user_id = $5)
+			  ORDER BY p.created_at`
+	rows, err := r.db.Query(ctx, query, model.PaymentPending, model.PaymentFailed, model.BookingPendingPayment, eventID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payments []model.Payment
+	for rows.Next() {
+		payment, err := scanPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, rows.Err()
+}
+
+func scanPayment(row scanner) (model.Payment, error) {
+	var payment model.Payment
+	err := row.Scan(
+		&payment.PaymentID,
+		&payment.BookingID,
+		&payment.Provider,
+		&payment.IntentID,
+		&payment.Amount,
+		&payment.Currency,
+		&payment.Status,
+		&payment.CreatedAt,
+		&payment.UpdatedAt,
+		&payment.RetryAt,
+	)
+	return payment, err
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionRepo is the Postgres implementation of the refresh token store.
+type SessionRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewSessionRepo(db *pgxpool.Pool) *SessionRepo {
+	return &SessionRepo{db: db}
+}
+
+func (r *SessionRepo) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	query := `INSERT INTO refresh_tokens (token_id, family_id, subject_id, role, token_hash, expires_at, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, NOW())`
+	if _, err := r.db.Exec(ctx, query, token.TokenID, token.FamilyID, token.SubjectID, token.Role, token.TokenHash, token.ExpiresAt); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *SessionRepo) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (model.RefreshToken, error) {
+	var token model.RefreshToken
+	query := `SELECT token_id, family_id, subject_id, role, token_hash, expires_at, revoked_at, replaced_by
+			  FROM refresh_tokens WHERE token_hash = $1`
+	if err := r.db.QueryRow(ctx, query, tokenHash).Scan(
+		&token.TokenID,
+		&token.FamilyID,
+		&token.SubjectID,
+		&token.Role,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.ReplacedBy,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.RefreshToken{}, status.Errorf(codes.NotFound, "refresh token not found")
+		}
+		return model.RefreshToken{}, err
+	}
+	return token, nil
+}
+
+// RotateRefreshToken revokes oldTokenID and stores its replacement. It fails
+// with Unauthenticated if the old token was revoked concurrently, so two
+// racing refreshes cannot both succeed.
+func (r *SessionRepo) RotateRefreshToken(ctx context.Context, oldTokenID string, next model.RefreshToken) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE refresh_tokens SET revoked_at = NOW(), replaced_by = $2
+		WHERE token_id = $1 AND revoked_at IS NULL`, oldTokenID, next.TokenID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.Unauthenticated, "refresh token has been revoked")
+	}
+
+	query := `INSERT INTO refresh_tokens (token_id, family_id, subject_id, role, token_hash, expires_at, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, NOW())`
+	if _, err := tx.Exec(ctx, query, next.TokenID, next.FamilyID, next.SubjectID, next.Role, next.TokenHash, next.ExpiresAt); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *SessionRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE family_id = $1 AND revoked_at IS NULL`
+	if _, err := r.db.Exec(ctx, query, familyID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *SessionRepo) RevokeSubjectRefreshTokens(ctx context.Context, subjectID string) error {
+	query := `UPDATE refresh_tokens SET revoked_at = NOW() WHERE subject_id = $1 AND revoked_at IS NULL`
+	if _, err := r.db.Exec(ctx, query, subjectID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LoginThrottleRepo is the Postgres implementation of the failed sign-in
+// counters.
+type LoginThrottleRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewLoginThrottleRepo(db *pgxpool.Pool) *LoginThrottleRepo {
+	return &LoginThrottleRepo{db: db}
+}
+
+const throttleColumns = `throttle_key, failures, locked_until, last_failure_at`
+
+func (r *LoginThrottleRepo) GetLoginThrottle(ctx context.Context, key string) (model.LoginThrottle, error) {
+	throttle, err := scanThrottle(r.db.QueryRow(ctx, `SELECT `+throttleColumns+` FROM login_throttles WHERE throttle_key = $1`, key))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.LoginThrottle{}, status.Errorf(codes.NotFound, "no failed logins")
+		}
+		return model.LoginThrottle{}, err
+	}
+	return throttle, nil
+}
+
+// RecordLoginFailure counts in a single upsert so concurrent failures are
+// never lost.
+func (r *LoginThrottleRepo) RecordLoginFailure(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error) {
+	var failures int
+	err := r.db.QueryRow(ctx, `INSERT INTO login_throttles (throttle_key, failures, last_failure_at) VALUES ($1, 1, $2)
+		ON CONFLICT (throttle_key) DO UPDATE SET
+			failures = CASE WHEN login_throttles.last_failure_at < $3 THEN 1 ELSE login_throttles.failures + 1 END,
+			last_failure_at = $2
+		RETURNING failures`, key, now, now.Add(-resetAfter)).Scan(&failures)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	return failures, nil
+}
+
+func (r *LoginThrottleRepo) LockLogin(ctx context.Context, key string, until time.Time) error {
+	if _, err := r.db.Exec(ctx, `UPDATE login_throttles SET locked_until = $2 WHERE throttle_key = $1`, key, until); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *LoginThrottleRepo) ClearLoginThrottle(ctx context.Context, key string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM login_throttles WHERE throttle_key = $1`, key)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "no failed logins")
+	}
+	return nil
+}
+
+func (r *LoginThrottleRepo) ListLoginThrottles(ctx context.Context, lockedOnly bool, now time.Time, limit, offset int) ([]model.LoginThrottle, int, error) {
+	where := ""
+	args := []any{}
+	if lockedOnly {
+		where = ` WHERE locked_until > $1`
+		args = append(args, now)
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM login_throttles`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	args = append(args, limit, offset)
+	query := `SELECT ` + throttleColumns + ` FROM login_throttles` + where +
+		fmt.Sprintf(" ORDER BY last_failure_at DESC, throttle_key LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var throttles []model.LoginThrottle
+	for rows.Next() {
+		throttle, err := scanThrottle(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		throttles = append(throttles, throttle)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return throttles, total, nil
+}
+
+func scanThrottle(row scanner) (model.LoginThrottle, error) {
+	var throttle model.LoginThrottle
+	err := row.Scan(&throttle.Key, &throttle.Failures, &throttle.LockedUntil, &throttle.LastFailureAt)
+	return throttle, err
+}
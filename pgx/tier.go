@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const tierColumns = `t.tier_id, t.event_id, t.name, t.price_minor, t.currency, t.capacity, t.sold,
+	t.sales_start, t.sales_end, t.max_per_order, t.position, t.created_at`
+
+func (r *EventRepo) ListTicketTiers(ctx context.Context, orgID string, eventIDs []string) ([]model.TicketTier, error) {
+	if len(eventIDs) == 0 {
+		return nil, nil
+	}
+	query := `SELECT ` + tierColumns + ` FROM ticket_tiers t JOIN events e ON e.event_id = t.event_id
+			  WHERE t.event_id = ANY($1) AND e.org_id = $2 ORDER BY t.event_id, t.position`
+	rows, err := r.db.Query(ctx, query, eventIDs, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tiers []model.TicketTier
+	for rows.Next() {
+		tier, err := scanTicketTier(rows)
+		if err != nil {
+			return nil, err
+		}
+		tiers = append(tiers, tier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return tiers, nil
+}
+
+// insertTicketTiers adds the tiers of a new event inside its transaction.
+func insertTicketTiers(ctx context.Context, tx pgx.Tx, eventID string, tiers []model.TicketTier) error {
+	for _, tier := range tiers {
+		_, err := tx.Exec(ctx, `INSERT INTO ticket_tiers (tier_id, event_id, name, price_minor, currency, capacity,
+			sales_start, sales_end, max_per_order, position, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NOW())`,
+			tier.TierID, eventID, tier.Name, tier.PriceMinor, tier.Currency, tier.Capacity,
+			tier.SalesStart, tier.SalesEnd, tier.MaxPerOrder, tier.Position)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanTicketTier(row scanner) (model.TicketTier, error) {
+	var tier model.TicketTier
+	err := row.Scan(
+		&tier.TierID,
+		&tier.EventID,
+		&tier.Name,
+		&tier.PriceMinor,
+		&tier.Currency,
+		&tier.Capacity,
+		&tier.Sold,
+		&tier.SalesStart,
+		&tier.SalesEnd,
+		&tier.MaxPerOrder,
+		&tier.Position,
+		&tier.CreatedAt,
+	)
+	return tier, err
+}
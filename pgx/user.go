@@ -3,49 +3,313 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"eventpass/model"
-	"eventpass/utils"
+	"fmt"
+	"strings"
+	"time"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-type PostgresRepo struct {
+// UserRepo is the Postgres implementation of the user repository, covering
+// both the users and admins tables.
+type UserRepo struct {
 	db *pgxpool.Pool
 }
 
-func NewUserRepo(db *pgxpool.Pool) *PostgresRepo {
-	return &PostgresRepo{db: db}
+func NewUserRepo(db *pgxpool.Pool) *UserRepo {
+	return &UserRepo{db: db}
 }
 
-func CreateUser(ctx context.Context, userID, firstName, lastName, username, password, phone, email string) error {
+func (r *UserRepo) CreateUser(ctx context.Context, userID, email, password, firstName, lastName, username, phone string) error {
 	query := `INSERT INTO users (user_id, first_name, last_name, username, password, phone, email, created_at) 
 			  VALUES ($1, $2, $3, $4, $5, $6, $7, NOW())`
 	
-	if _, err := utils.DB.Exec(ctx, query, userID, firstName, lastName, username, password, phone, email); err != nil {
+	if _, err := r.db.Exec(ctx, query, userID, firstName, lastName, username, password, phone, email); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+func (r *UserRepo) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	return r.getUser(ctx, `username = $1`, username)
+}
+
+func (r *UserRepo) GetUser(ctx context.Context, userID string) (model.User, error) {
+	return r.getUser(ctx, `user_id = $1`, userID)
+}
+
+func (r *UserRepo) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	return r.getUser(ctx, `email = $1`, email)
+}
+
+func (r *UserRepo) UpdatePassword(ctx context.Context, userID, password string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE users SET password = $2 WHERE user_id = $1`, userID, password)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	return nil
+}
+
+func (r *UserRepo) MarkEmailVerified(ctx context.Context, userID string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE users SET email_verified_at = COALESCE(email_verified_at, NOW()) WHERE user_id = $1`, userID)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	return nil
+}
+
+func (r *UserRepo) UpdateProfile(ctx context.Context, userID string, update model.ProfileUpdate) (model.User, error) {
+	var sets []string
+	var args []any
+	set := func(column string, v any) {
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d", column, len(args)))
+	}
+	if update.FirstName != nil {
+		set("first_name", *update.FirstName)
+	}
+	if update.LastName != nil {
+		set("last_name", *update.LastName)
+	}
+	if update.Phone != nil {
+		set("phone", *update.Phone)
+	}
+
+	if len(sets) > 0 {
+		args = append(args, userID)
+		query := fmt.Sprintf(`UPDATE users SET %s WHERE user_id = $%d`, strings.Join(sets, ", "), len(args))
+		tag, err := r.db.Exec(ctx, query, args...)
+		if err != nil {
+			return model.User{}, translateError(err)
+		}
+		if tag.RowsAffected() == 0 {
+			return model.User{}, status.Errorf(codes.NotFound, "user not found")
+		}
+	}
+	return r.GetUser(ctx, userID)
+}
+
+func (r *UserRepo) ChangeEmail(ctx context.Context, userID, email string) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `UPDATE users SET email = $2, email_verified_at = NULL WHERE user_id = $1`, userID, email)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE user_tokens SET used_at = NOW()
+			  WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`, userID, model.PurposeEmailVerification)
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *UserRepo) DeleteUser(ctx context.Context, userID string) (err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// An account is shared by every organization it joined, so unlike the
+	// event queries this spans all of them
+	var organizes bool
+	if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM events WHERE created_by_user = $1)`, userID).Scan(&organizes); err != nil {
+		return err
+	}
+	if organizes {
+		return status.Errorf(codes.FailedPrecondition, "delete the events you organize first")
+	}
+	var paid bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM payments p JOIN bookings b ON b.booking_id = p.booking_id
+		WHERE b.user_id = $1 AND p.status IN ($2, $3, $4))`,
+		userID, model.PaymentSucceeded, model.PaymentRefundPending, model.PaymentRefunded).Scan(&paid)
+	if err != nil {
+		return err
+	}
+	if paid {
+		return status.Errorf(codes.FailedPrecondition, "accounts with paid bookings cannot be deleted")
+	}
+
+	// Give back the slots of events that have not happened yet
+	_, err = tx.Exec(ctx, `UPDATE events e SET booked_slots = e.booked_slots - b.slots
+			  FROM (SELECT event_id, SUM(quantity) AS slots FROM bookings
+			        WHERE user_id = $1 AND status IN ($2, $4) GROUP BY event_id) b
+			  WHERE e.event_id = b.event_id AND e.status <> $3`,
+		userID, model.BookingConfirmed, model.EventCompleted, model.BookingPendingPayment)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `UPDATE ticket_tiers t SET sold = t.sold - b.slots
+			  FROM (SELECT tier_id, SUM(quantity) AS slots FROM bookings
+			        WHERE user_id = $1 AND status IN ($2, $4) AND tier_id IS NOT NULL GROUP BY tier_id) b, events e
+			  WHERE t.tier_id = b.tier_id AND e.event_id = t.event_id AND e.status <> $3`,
+		userID, model.BookingConfirmed, model.EventCompleted, model.BookingPendingPayment)
+	if err != nil {
+		return err
+	}
+
+	// And those of the user's seat holds
+	_, err = tx.Exec(ctx, `UPDATE events e SET booked_slots = GREATEST(e.booked_slots - h.slots, 0)
+			  FROM (SELECT event_id, SUM(quantity) AS slots FROM seat_holds
+			        WHERE user_id = $1 GROUP BY event_id) h
+			  WHERE e.event_id = h.event_id`, userID)
+	if err != nil {
 		return err
 	}
+	_, err = tx.Exec(ctx, `UPDATE ticket_tiers t SET sold = GREATEST(t.sold - h.slots, 0)
+			  FROM (SELECT tier_id, SUM(quantity) AS slots FROM seat_holds
+			        WHERE user_id = $1 AND tier_id IS NOT NULL GROUP BY tier_id) h
+			  WHERE t.tier_id = h.tier_id`, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, query := range []string{
+		`DELETE FROM seat_holds WHERE user_id = $1`,
+		`DELETE FROM bookings WHERE user_id = $1`,
+		`DELETE FROM refresh_tokens WHERE subject_id = $1`,
+		`DELETE FROM mfa_factors WHERE subject_id = $1`,
+		`DELETE FROM api_keys WHERE owner_id = $1`,
+	} {
+		if _, err := tx.Exec(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+
+	// user_tokens and user_identities go with the user (ON DELETE CASCADE)
+	tag, err := tx.Exec(ctx, `DELETE FROM users WHERE user_id = $1`, userID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *UserRepo) ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error) {
+	var conds []string
+	var args []any
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.Search != "" {
+		pattern := arg("%" + filter.Search + "%")
+		conds = append(conds, "(username ILIKE "+pattern+" OR email ILIKE "+pattern+")")
+	}
+	if filter.Role != "" {
+		conds = append(conds, "role = "+arg(filter.Role))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		conds = append(conds, "created_at >= "+arg(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		conds = append(conds, "created_at < "+arg(filter.CreatedTo))
+	}
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	var total int
+	if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM users`+where, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + userColumns + ` FROM users` + where +
+		" ORDER BY created_at DESC, user_id LIMIT " + arg(filter.Limit) + " OFFSET " + arg(filter.Offset)
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var users []model.User
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		users = append(users, user)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (r *UserRepo) SuspendUser(ctx context.Context, userID, reason string, at time.Time) error {
+	return r.setSuspended(ctx, userID, `suspended_at = $2, suspend_reason = $3 WHERE user_id = $1 AND suspended_at IS NULL`,
+		"user is already suspended", userID, at, reason)
+}
+
+func (r *UserRepo) ReactivateUser(ctx context.Context, userID string) error {
+	return r.setSuspended(ctx, userID, `suspended_at = NULL, suspend_reason = '' WHERE user_id = $1 AND suspended_at IS NOT NULL`,
+		"user is not suspended", userID)
+}
+
+// setSuspended runs a conditional update and tells a missing user apart from
+// one already in the requested state.
+func (r *UserRepo) setSuspended(ctx context.Context, userID, update, conflict string, args ...any) error {
+	tag, err := r.db.Exec(ctx, `UPDATE users SET `+update, args...)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+	if _, err := r.GetUser(ctx, userID); err != nil {
+		return err
+	}
+	return status.Errorf(codes.FailedPrecondition, "%s", conflict)
+}
+
+func (r *UserRepo) SetUserRole(ctx context.Context, userID, role string) error {
+	tag, err := r.db.Exec(ctx, `UPDATE users SET role = $2 WHERE user_id = $1`, userID, role)
+	if err != nil {
+		return translateError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
 	return nil
 }
 
-func GetUserByUsername(ctx context.Context, username string) (model.User, error) {
-	var user model.User
-	query := `SELECT user_id, first_name, last_name, username, password, phone, email, created_at 
-			  FROM users WHERE username = $1`
-	
-	if err := utils.DB.QueryRow(ctx, query, username).Scan(
-		&user.UserID,
-		&user.FirstName,
-		&user.LastName,
-		&user.Username,
-		&user.Password,
-		&user.Phone,
-		&user.Email,
-		&user.CreatedAt,
-	); err != nil {
-		if err == pgx.ErrNoRows {
+const userColumns = `user_id, first_name, last_name, username, password, phone, email, created_at,
+			  email_verified_at, role, suspended_at, suspend_reason`
+
+func (r *UserRepo) getUser(ctx context.Context, where string, arg string) (model.User, error) {
+	query := `SELECT ` + userColumns + ` FROM users WHERE ` + where
+
+	user, err := scanUser(r.db.QueryRow(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
 			return model.User{}, status.Errorf(codes.NotFound, "user not found")
 		}
 		return model.User{}, err
@@ -53,12 +317,9 @@ func GetUserByUsername(ctx context.Context, username string) (model.User, error)
 	return user, nil
 }
 
-func GetUserByID(ctx context.Context, userID string) (model.User, error) {
+func scanUser(row pgx.Row) (model.User, error) {
 	var user model.User
-	query := `SELECT user_id, first_name, last_name, username, password, phone, email, created_at 
-			  FROM users WHERE user_id = $1`
-	
-	if err := utils.DB.QueryRow(ctx, query, userID).Scan(
+	err := row.Scan(
 		&user.UserID,
 		&user.FirstName,
 		&user.LastName,
@@ -67,11 +328,10 @@ func GetUserByID(ctx context.Context, userID string) (model.User, error) {
 		&user.Phone,
 		&user.Email,
 		&user.CreatedAt,
-	); err != nil {
-		if err == pgx.ErrNoRows {
-			return model.User{}, status.Errorf(codes.NotFound, "user not found")
-		}
-		return model.User{}, err
-	}
-	return user, nil
-}
\ No newline at end of file
+		&user.EmailVerifiedAt,
+		&user.Role,
+		&user.SuspendedAt,
+		&user.SuspendReason,
+	)
+	return user, err
+}
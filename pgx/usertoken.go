@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UserTokenRepo is the Postgres implementation of the single-use token store.
+type UserTokenRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewUserTokenRepo(db *pgxpool.Pool) *UserTokenRepo {
+	return &UserTokenRepo{db: db}
+}
+
+func (r *UserTokenRepo) CreateUserToken(ctx context.Context, token model.UserToken) error {
+	query := `INSERT INTO user_tokens (token_id, user_id, purpose, token_hash, expires_at, created_at)
+			  VALUES ($1, $2, $3, $4, $5, NOW())`
+	if _, err := r.db.Exec(ctx, query, token.TokenID, token.UserID, token.Purpose, token.TokenHash, token.ExpiresAt); err != nil {
+		return translateError(err)
+	}
+	return nil
+}
+
+// ConsumeUserToken claims the token with a conditional UPDATE, so two requests
+// racing with the same token cannot both succeed. Expiry is compared against
+// the application clock, which is also what wrote expires_at.
+func (r *UserTokenRepo) ConsumeUserToken(ctx context.Context, purpose, tokenHash string) (_ model.UserToken, err error) {
+	defer func() { err = translateError(err) }()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return model.UserToken{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var token model.UserToken
+	err = tx.QueryRow(ctx, `UPDATE user_tokens SET used_at = NOW()
+		WHERE token_hash = $1 AND purpose = $2 AND used_at IS NULL AND expires_at > $3
+		RETURNING token_id, user_id, purpose, token_hash, expires_at, used_at`, tokenHash, purpose, time.Now()).Scan(
+		&token.TokenID,
+		&token.UserID,
+		&token.Purpose,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.UsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.UserToken{}, status.Errorf(codes.NotFound, "token not found")
+		}
+		return model.UserToken{}, err
+	}
+
+	// Older links for the same purpose stop working too
+	if _, err := tx.Exec(ctx, `UPDATE user_tokens SET used_at = NOW()
+		WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`, token.UserID, purpose); err != nil {
+		return model.UserToken{}, err
+	}
+	return token, tx.Commit(ctx)
+}
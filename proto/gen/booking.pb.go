@@ -0,0 +1,1380 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: booking.proto
+
+package gen
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Bookings always belong to the authenticated caller.
+type BookEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Required when the event has ticket tiers
+	TierId string `protobuf:"bytes,3,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	// Number of tickets; defaults to 1
+	Quantity int32 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Book the tickets of a seat hold instead; event_id must match it and
+	// tier_id and quantity are taken from the hold
+	HoldId        string `protobuf:"bytes,5,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookEventRequest) Reset() {
+	*x = BookEventRequest{}
+	mi := &file_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookEventRequest) ProtoMessage() {}
+
+func (x *BookEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookEventRequest.ProtoReflect.Descriptor instead.
+func (*BookEventRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BookEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *BookEventRequest) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *BookEventRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BookEventRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type BookEventResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Message   string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	BookingId string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// "confirmed" for free tickets; "pending_payment" until the payment
+	// below comes through
+	Status        string          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Payment       *BookingPayment `protobuf:"bytes,4,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookEventResponse) Reset() {
+	*x = BookEventResponse{}
+	mi := &file_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookEventResponse) ProtoMessage() {}
+
+func (x *BookEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookEventResponse.ProtoReflect.Descriptor instead.
+func (*BookEventResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BookEventResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BookEventResponse) GetPayment() *BookingPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+// What the client needs to pay for a booking with the processor.
+type BookingPayment struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Provider     string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	IntentId     string                 `protobuf:"bytes,2,opt,name=intent_id,json=intentId,proto3" json:"intent_id,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	AmountMinor  int64                  `protobuf:"varint,4,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	Currency     string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// The booking is released if the payment has not succeeded by then
+	ExpiresAt     string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookingPayment) Reset() {
+	*x = BookingPayment{}
+	mi := &file_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingPayment) ProtoMessage() {}
+
+func (x *BookingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingPayment.ProtoReflect.Descriptor instead.
+func (*BookingPayment) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingPayment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *BookingPayment) GetIntentId() string {
+	if x != nil {
+		return x.IntentId
+	}
+	return ""
+}
+
+func (x *BookingPayment) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *BookingPayment) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *BookingPayment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *BookingPayment) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type ListMyBookingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional status filter: "confirmed", "pending_payment", "cancelled"
+	// or "expired".
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsRequest) Reset() {
+	*x = ListMyBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsRequest) ProtoMessage() {}
+
+func (x *ListMyBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMyBookingsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyBookingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMyBookingsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListMyBookingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      []*GetBookingResponse  `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBookingsResponse) Reset() {
+	*x = ListMyBookingsResponse{}
+	mi := &file_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBookingsResponse) ProtoMessage() {}
+
+func (x *ListMyBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListMyBookingsResponse) GetBookings() []*GetBookingResponse {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *ListMyBookingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingRequest) Reset() {
+	*x = GetBookingRequest{}
+	mi := &file_booking_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingRequest) ProtoMessage() {}
+
+func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BookingId        string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	EventId          string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CancelledAt      string                 `protobuf:"bytes,6,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	EventTitle       string                 `protobuf:"bytes,7,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	EventDescription string                 `protobuf:"bytes,8,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`
+	EventLocation    string                 `protobuf:"bytes,9,opt,name=event_location,json=eventLocation,proto3" json:"event_location,omitempty"`
+	EventDate        string                 `protobuf:"bytes,10,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	EventStartTime   string                 `protobuf:"bytes,11,opt,name=event_start_time,json=eventStartTime,proto3" json:"event_start_time,omitempty"`
+	EventEndTime     string                 `protobuf:"bytes,12,opt,name=event_end_time,json=eventEndTime,proto3" json:"event_end_time,omitempty"`
+	// Set when the booking was cancelled because the event was
+	CancelReason string `protobuf:"bytes,13,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	CheckedInAt  string `protobuf:"bytes,14,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	// Empty for events without ticket tiers. The name and price are those at
+	// the time of booking.
+	TierId          string `protobuf:"bytes,15,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	TierName        string `protobuf:"bytes,16,opt,name=tier_name,json=tierName,proto3" json:"tier_name,omitempty"`
+	Quantity        int32  `protobuf:"varint,17,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceMinor  int64  `protobuf:"varint,18,opt,name=unit_price_minor,json=unitPriceMinor,proto3" json:"unit_price_minor,omitempty"`
+	Currency        string `protobuf:"bytes,19,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalPriceMinor int64  `protobuf:"varint,20,opt,name=total_price_minor,json=totalPriceMinor,proto3" json:"total_price_minor,omitempty"`
+	// Set while the booking is pending_payment
+	ExpiresAt     string `protobuf:"bytes,21,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBookingResponse) Reset() {
+	*x = GetBookingResponse{}
+	mi := &file_booking_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingResponse) ProtoMessage() {}
+
+func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetCancelledAt() string {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventDescription() string {
+	if x != nil {
+		return x.EventDescription
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventLocation() string {
+	if x != nil {
+		return x.EventLocation
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventDate() string {
+	if x != nil {
+		return x.EventDate
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventStartTime() string {
+	if x != nil {
+		return x.EventStartTime
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetEventEndTime() string {
+	if x != nil {
+		return x.EventEndTime
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetTierName() string {
+	if x != nil {
+		return x.TierName
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetUnitPriceMinor() int64 {
+	if x != nil {
+		return x.UnitPriceMinor
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetBookingResponse) GetTotalPriceMinor() int64 {
+	if x != nil {
+		return x.TotalPriceMinor
+	}
+	return 0
+}
+
+func (x *GetBookingResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type CancelBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingRequest) Reset() {
+	*x = CancelBookingRequest{}
+	mi := &file_booking_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingRequest) ProtoMessage() {}
+
+func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
+func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CancelBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckInBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInBookingRequest) Reset() {
+	*x = CheckInBookingRequest{}
+	mi := &file_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInBookingRequest) ProtoMessage() {}
+
+func (x *CheckInBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInBookingRequest.ProtoReflect.Descriptor instead.
+func (*CheckInBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckInBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type CheckInBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Booking       *GetBookingResponse    `protobuf:"bytes,2,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInBookingResponse) Reset() {
+	*x = CheckInBookingResponse{}
+	mi := &file_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInBookingResponse) ProtoMessage() {}
+
+func (x *CheckInBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInBookingResponse.ProtoReflect.Descriptor instead.
+func (*CheckInBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CheckInBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckInBookingResponse) GetBooking() *GetBookingResponse {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type SeatHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TierId        string                 `protobuf:"bytes,3,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatHold) Reset() {
+	*x = SeatHold{}
+	mi := &file_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatHold) ProtoMessage() {}
+
+func (x *SeatHold) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatHold.ProtoReflect.Descriptor instead.
+func (*SeatHold) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SeatHold) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *SeatHold) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SeatHold) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *SeatHold) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SeatHold) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *SeatHold) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateSeatHoldRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Required when the event has ticket tiers
+	TierId string `protobuf:"bytes,2,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	// Number of tickets; defaults to 1
+	Quantity      int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeatHoldRequest) Reset() {
+	*x = CreateSeatHoldRequest{}
+	mi := &file_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeatHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeatHoldRequest) ProtoMessage() {}
+
+func (x *CreateSeatHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeatHoldRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeatHoldRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSeatHoldRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateSeatHoldRequest) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *CreateSeatHoldRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type CreateSeatHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Hold          *SeatHold              `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeatHoldResponse) Reset() {
+	*x = CreateSeatHoldResponse{}
+	mi := &file_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeatHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeatHoldResponse) ProtoMessage() {}
+
+func (x *CreateSeatHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeatHoldResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeatHoldResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateSeatHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateSeatHoldResponse) GetHold() *SeatHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// Restarts the hold's time to live, up to a maximum age.
+type ExtendSeatHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendSeatHoldRequest) Reset() {
+	*x = ExtendSeatHoldRequest{}
+	mi := &file_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendSeatHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendSeatHoldRequest) ProtoMessage() {}
+
+func (x *ExtendSeatHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendSeatHoldRequest.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExtendSeatHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type ExtendSeatHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Hold          *SeatHold              `protobuf:"bytes,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendSeatHoldResponse) Reset() {
+	*x = ExtendSeatHoldResponse{}
+	mi := &file_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendSeatHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendSeatHoldResponse) ProtoMessage() {}
+
+func (x *ExtendSeatHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendSeatHoldResponse.ProtoReflect.Descriptor instead.
+func (*ExtendSeatHoldResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExtendSeatHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExtendSeatHoldResponse) GetHold() *SeatHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReleaseSeatHoldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatHoldRequest) Reset() {
+	*x = ReleaseSeatHoldRequest{}
+	mi := &file_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatHoldRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatHoldRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatHoldRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReleaseSeatHoldRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+type ReleaseSeatHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatHoldResponse) Reset() {
+	*x = ReleaseSeatHoldResponse{}
+	mi := &file_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatHoldResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatHoldResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatHoldResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReleaseSeatHoldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_booking_proto protoreflect.FileDescriptor
+
+const file_booking_proto_rawDesc = "" +
+	"\n" +
+	"\rbooking.proto\x12\abooking\x1a\x1cgoogle/api/annotations.proto\"\x8a\x01\n" +
+	"\x10BookEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\atier_id\x18\x03 \x01(\tR\x06tierId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x17\n" +
+	"\ahold_id\x18\x05 \x01(\tR\x06holdIdJ\x04\b\x02\x10\x03R\auser_id\"\x97\x01\n" +
+	"\x11BookEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x121\n" +
+	"\apayment\x18\x04 \x01(\v2\x17.booking.BookingPaymentR\apayment\"\xcc\x01\n" +
+	"\x0eBookingPayment\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1b\n" +
+	"\tintent_id\x18\x02 \x01(\tR\bintentId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\x12!\n" +
+	"\famount_minor\x18\x04 \x01(\x03R\vamountMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"h\n" +
+	"\x15ListMyBookingsRequest\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06statusJ\x04\b\x01\x10\x02R\auser_id\"g\n" +
+	"\x16ListMyBookingsResponse\x127\n" +
+	"\bbookings\x18\x01 \x03(\v2\x1b.booking.GetBookingResponseR\bbookings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"A\n" +
+	"\x11GetBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingIdJ\x04\b\x02\x10\x03R\auser_id\"\xd1\x05\n" +
+	"\x12GetBookingResponse\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcancelled_at\x18\x06 \x01(\tR\vcancelledAt\x12\x1f\n" +
+	"\vevent_title\x18\a \x01(\tR\n" +
+	"eventTitle\x12+\n" +
+	"\x11event_description\x18\b \x01(\tR\x10eventDescription\x12%\n" +
+	"\x0eevent_location\x18\t \x01(\tR\reventLocation\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\n" +
+	" \x01(\tR\teventDate\x12(\n" +
+	"\x10event_start_time\x18\v \x01(\tR\x0eeventStartTime\x12$\n" +
+	"\x0eevent_end_time\x18\f \x01(\tR\feventEndTime\x12#\n" +
+	"\rcancel_reason\x18\r \x01(\tR\fcancelReason\x12\"\n" +
+	"\rchecked_in_at\x18\x0e \x01(\tR\vcheckedInAt\x12\x17\n" +
+	"\atier_id\x18\x0f \x01(\tR\x06tierId\x12\x1b\n" +
+	"\ttier_name\x18\x10 \x01(\tR\btierName\x12\x1a\n" +
+	"\bquantity\x18\x11 \x01(\x05R\bquantity\x12(\n" +
+	"\x10unit_price_minor\x18\x12 \x01(\x03R\x0eunitPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x13 \x01(\tR\bcurrency\x12*\n" +
+	"\x11total_price_minor\x18\x14 \x01(\x03R\x0ftotalPriceMinor\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x15 \x01(\tR\texpiresAt\"D\n" +
+	"\x14CancelBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingIdJ\x04\b\x02\x10\x03R\auser_id\"1\n" +
+	"\x15CancelBookingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"6\n" +
+	"\x15CheckInBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"i\n" +
+	"\x16CheckInBookingResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x125\n" +
+	"\abooking\x18\x02 \x01(\v2\x1b.booking.GetBookingResponseR\abooking\"\xb1\x01\n" +
+	"\bSeatHold\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\atier_id\x18\x03 \x01(\tR\x06tierId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"g\n" +
+	"\x15CreateSeatHoldRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\atier_id\x18\x02 \x01(\tR\x06tierId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"Y\n" +
+	"\x16CreateSeatHoldResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12%\n" +
+	"\x04hold\x18\x02 \x01(\v2\x11.booking.SeatHoldR\x04hold\"0\n" +
+	"\x15ExtendSeatHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\"Y\n" +
+	"\x16ExtendSeatHoldResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12%\n" +
+	"\x04hold\x18\x02 \x01(\v2\x11.booking.SeatHoldR\x04hold\"1\n" +
+	"\x16ReleaseSeatHoldRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\"3\n" +
+	"\x17ReleaseSeatHoldResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\x96\a\n" +
+	"\x0eBookingService\x12[\n" +
+	"\tBookEvent\x12\x19.booking.BookEventRequest\x1a\x1a.booking.BookEventResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/bookings\x12g\n" +
+	"\x0eListMyBookings\x12\x1e.booking.ListMyBookingsRequest\x1a\x1f.booking.ListMyBookingsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/bookings\x12h\n" +
+	"\n" +
+	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x1b.booking.GetBookingResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/bookings/{booking_id}\x12{\n" +
+	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/bookings/{booking_id}/cancel\x12\x80\x01\n" +
+	"\x0eCheckInBooking\x12\x1e.booking.CheckInBookingRequest\x1a\x1f.booking.CheckInBookingResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/bookings/{booking_id}/check-in\x12g\n" +
+	"\x0eCreateSeatHold\x12\x1e.booking.CreateSeatHoldRequest\x1a\x1f.booking.CreateSeatHoldResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/holds\x12x\n" +
+	"\x0eExtendSeatHold\x12\x1e.booking.ExtendSeatHoldRequest\x1a\x1f.booking.ExtendSeatHoldResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/holds/{hold_id}/extend\x12q\n" +
+	"\x0fReleaseSeatHold\x12\x1f.booking.ReleaseSeatHoldRequest\x1a .booking.ReleaseSeatHoldResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v1/holds/{hold_id}B\aZ\x05./genb\x06proto3"
+
+var (
+	file_booking_proto_rawDescOnce sync.Once
+	file_booking_proto_rawDescData []byte
+)
+
+func file_booking_proto_rawDescGZIP() []byte {
+	file_booking_proto_rawDescOnce.Do(func() {
+		file_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)))
+	})
+	return file_booking_proto_rawDescData
+}
+
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_booking_proto_goTypes = []any{
+	(*BookEventRequest)(nil),        // 0: booking.BookEventRequest
+	(*BookEventResponse)(nil),       // 1: booking.BookEventResponse
+	(*BookingPayment)(nil),          // 2: booking.BookingPayment
+	(*ListMyBookingsRequest)(nil),   // 3: booking.ListMyBookingsRequest
+	(*ListMyBookingsResponse)(nil),  // 4: booking.ListMyBookingsResponse
+	(*GetBookingRequest)(nil),       // 5: booking.GetBookingRequest
+	(*GetBookingResponse)(nil),      // 6: booking.GetBookingResponse
+	(*CancelBookingRequest)(nil),    // 7: booking.CancelBookingRequest
+	(*CancelBookingResponse)(nil),   // 8: booking.CancelBookingResponse
+	(*CheckInBookingRequest)(nil),   // 9: booking.CheckInBookingRequest
+	(*CheckInBookingResponse)(nil),  // 10: booking.CheckInBookingResponse
+	(*SeatHold)(nil),                // 11: booking.SeatHold
+	(*CreateSeatHoldRequest)(nil),   // 12: booking.CreateSeatHoldRequest
+	(*CreateSeatHoldResponse)(nil),  // 13: booking.CreateSeatHoldResponse
+	(*ExtendSeatHoldRequest)(nil),   // 14: booking.ExtendSeatHoldRequest
+	(*ExtendSeatHoldResponse)(nil),  // 15: booking.ExtendSeatHoldResponse
+	(*ReleaseSeatHoldRequest)(nil),  // 16: booking.ReleaseSeatHoldRequest
+	(*ReleaseSeatHoldResponse)(nil), // 17: booking.ReleaseSeatHoldResponse
+}
+var file_booking_proto_depIdxs = []int32{
+	2,  // 0: booking.BookEventResponse.payment:type_name -> booking.BookingPayment
+	6,  // 1: booking.ListMyBookingsResponse.bookings:type_name -> booking.GetBookingResponse
+	6,  // 2: booking.CheckInBookingResponse.booking:type_name -> booking.GetBookingResponse
+	11, // 3: booking.CreateSeatHoldResponse.hold:type_name -> booking.SeatHold
+	11, // 4: booking.ExtendSeatHoldResponse.hold:type_name -> booking.SeatHold
+	0,  // 5: booking.BookingService.BookEvent:input_type -> booking.BookEventRequest
+	3,  // 6: booking.BookingService.ListMyBookings:input_type -> booking.ListMyBookingsRequest
+	5,  // 7: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	7,  // 8: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	9,  // 9: booking.BookingService.CheckInBooking:input_type -> booking.CheckInBookingRequest
+	12, // 10: booking.BookingService.CreateSeatHold:input_type -> booking.CreateSeatHoldRequest
+	14, // 11: booking.BookingService.ExtendSeatHold:input_type -> booking.ExtendSeatHoldRequest
+	16, // 12: booking.BookingService.ReleaseSeatHold:input_type -> booking.ReleaseSeatHoldRequest
+	1,  // 13: booking.BookingService.BookEvent:output_type -> booking.BookEventResponse
+	4,  // 14: booking.BookingService.ListMyBookings:output_type -> booking.ListMyBookingsResponse
+	6,  // 15: booking.BookingService.GetBooking:output_type -> booking.GetBookingResponse
+	8,  // 16: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
+	10, // 17: booking.BookingService.CheckInBooking:output_type -> booking.CheckInBookingResponse
+	13, // 18: booking.BookingService.CreateSeatHold:output_type -> booking.CreateSeatHoldResponse
+	15, // 19: booking.BookingService.ExtendSeatHold:output_type -> booking.ExtendSeatHoldResponse
+	17, // 20: booking.BookingService.ReleaseSeatHold:output_type -> booking.ReleaseSeatHoldResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_booking_proto_init() }
+func file_booking_proto_init() {
+	if File_booking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_booking_proto_goTypes,
+		DependencyIndexes: file_booking_proto_depIdxs,
+		MessageInfos:      file_booking_proto_msgTypes,
+	}.Build()
+	File_booking_proto = out.File
+	file_booking_proto_goTypes = nil
+	file_booking_proto_depIdxs = nil
+}
@@ -0,0 +1,401 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: booking.proto
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BookingService_BookEvent_FullMethodName       = "/booking.BookingService/BookEvent"
+	BookingService_ListMyBookings_FullMethodName  = "/booking.BookingService/ListMyBookings"
+	BookingService_GetBooking_FullMethodName      = "/booking.BookingService/GetBooking"
+	BookingService_CancelBooking_FullMethodName   = "/booking.BookingService/CancelBooking"
+	BookingService_CheckInBooking_FullMethodName  = "/booking.BookingService/CheckInBooking"
+	BookingService_CreateSeatHold_FullMethodName  = "/booking.BookingService/CreateSeatHold"
+	BookingService_ExtendSeatHold_FullMethodName  = "/booking.BookingService/ExtendSeatHold"
+	BookingService_ReleaseSeatHold_FullMethodName = "/booking.BookingService/ReleaseSeatHold"
+)
+
+// BookingServiceClient is the client API for BookingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BookingServiceClient interface {
+	BookEvent(ctx context.Context, in *BookEventRequest, opts ...grpc.CallOption) (*BookEventResponse, error)
+	ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error)
+	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
+	// Cancel a free or unpaid booking; paid bookings are only refunded when
+	// their event is cancelled
+	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	// Mark an attendee as arrived; for the event's owners, co-organizers and
+	// check-in staff
+	CheckInBooking(ctx context.Context, in *CheckInBookingRequest, opts ...grpc.CallOption) (*CheckInBookingResponse, error)
+	// Keep tickets aside during checkout. They are taken from the event's
+	// availability until the hold expires, is released, or is booked by
+	// passing its hold_id to BookEvent.
+	CreateSeatHold(ctx context.Context, in *CreateSeatHoldRequest, opts ...grpc.CallOption) (*CreateSeatHoldResponse, error)
+	ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldResponse, error)
+	ReleaseSeatHold(ctx context.Context, in *ReleaseSeatHoldRequest, opts ...grpc.CallOption) (*ReleaseSeatHoldResponse, error)
+}
+
+type bookingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBookingServiceClient(cc grpc.ClientConnInterface) BookingServiceClient {
+	return &bookingServiceClient{cc}
+}
+
+func (c *bookingServiceClient) BookEvent(ctx context.Context, in *BookEventRequest, opts ...grpc.CallOption) (*BookEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookEventResponse)
+	err := c.cc.Invoke(ctx, BookingService_BookEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListMyBookings(ctx context.Context, in *ListMyBookingsRequest, opts ...grpc.CallOption) (*ListMyBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListMyBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CheckInBooking(ctx context.Context, in *CheckInBookingRequest, opts ...grpc.CallOption) (*CheckInBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_CheckInBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateSeatHold(ctx context.Context, in *CreateSeatHoldRequest, opts ...grpc.CallOption) (*CreateSeatHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSeatHoldResponse)
+	err := c.cc.Invoke(ctx, BookingService_CreateSeatHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ExtendSeatHold(ctx context.Context, in *ExtendSeatHoldRequest, opts ...grpc.CallOption) (*ExtendSeatHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendSeatHoldResponse)
+	err := c.cc.Invoke(ctx, BookingService_ExtendSeatHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ReleaseSeatHold(ctx context.Context, in *ReleaseSeatHoldRequest, opts ...grpc.CallOption) (*ReleaseSeatHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSeatHoldResponse)
+	err := c.cc.Invoke(ctx, BookingService_ReleaseSeatHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookingServiceServer is the server API for BookingService service.
+// All implementations must embed UnimplementedBookingServiceServer
+// for forward compatibility.
+type BookingServiceServer interface {
+	BookEvent(context.Context, *BookEventRequest) (*BookEventResponse, error)
+	ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error)
+	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
+	// Cancel a free or unpaid booking; paid bookings are only refunded when
+	// their event is cancelled
+	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	// Mark an attendee as arrived; for the event's owners, co-organizers and
+	// check-in staff
+	CheckInBooking(context.Context, *CheckInBookingRequest) (*CheckInBookingResponse, error)
+	// Keep tickets aside during checkout. They are taken from the event's
+	// availability until the hold expires, is released, or is booked by
+	// passing its hold_id to BookEvent.
+	CreateSeatHold(context.Context, *CreateSeatHoldRequest) (*CreateSeatHoldResponse, error)
+	ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldResponse, error)
+	ReleaseSeatHold(context.Context, *ReleaseSeatHoldRequest) (*ReleaseSeatHoldResponse, error)
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+// UnimplementedBookingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookingServiceServer struct{}
+
+func (UnimplementedBookingServiceServer) BookEvent(context.Context, *BookEventRequest) (*BookEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookEvent not implemented")
+}
+func (UnimplementedBookingServiceServer) ListMyBookings(context.Context, *ListMyBookingsRequest) (*ListMyBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CheckInBooking(context.Context, *CheckInBookingRequest) (*CheckInBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateSeatHold(context.Context, *CreateSeatHoldRequest) (*CreateSeatHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeatHold not implemented")
+}
+func (UnimplementedBookingServiceServer) ExtendSeatHold(context.Context, *ExtendSeatHoldRequest) (*ExtendSeatHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendSeatHold not implemented")
+}
+func (UnimplementedBookingServiceServer) ReleaseSeatHold(context.Context, *ReleaseSeatHoldRequest) (*ReleaseSeatHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSeatHold not implemented")
+}
+func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
+func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookingServiceServer will
+// result in compilation errors.
+type UnsafeBookingServiceServer interface {
+	mustEmbedUnimplementedBookingServiceServer()
+}
+
+func RegisterBookingServiceServer(s grpc.ServiceRegistrar, srv BookingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookingService_ServiceDesc, srv)
+}
+
+func _BookingService_BookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).BookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_BookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).BookEvent(ctx, req.(*BookEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListMyBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListMyBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListMyBookings(ctx, req.(*ListMyBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetBooking(ctx, req.(*GetBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelBooking(ctx, req.(*CancelBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CheckInBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckInBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CheckInBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckInBooking(ctx, req.(*CheckInBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateSeatHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeatHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateSeatHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CreateSeatHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateSeatHold(ctx, req.(*CreateSeatHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ExtendSeatHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendSeatHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExtendSeatHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ExtendSeatHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExtendSeatHold(ctx, req.(*ExtendSeatHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ReleaseSeatHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeatHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ReleaseSeatHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ReleaseSeatHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ReleaseSeatHold(ctx, req.(*ReleaseSeatHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "booking.BookingService",
+	HandlerType: (*BookingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BookEvent",
+			Handler:    _BookingService_BookEvent_Handler,
+		},
+		{
+			MethodName: "ListMyBookings",
+			Handler:    _BookingService_ListMyBookings_Handler,
+		},
+		{
+			MethodName: "GetBooking",
+			Handler:    _BookingService_GetBooking_Handler,
+		},
+		{
+			MethodName: "CancelBooking",
+			Handler:    _BookingService_CancelBooking_Handler,
+		},
+		{
+			MethodName: "CheckInBooking",
+			Handler:    _BookingService_CheckInBooking_Handler,
+		},
+		{
+			MethodName: "CreateSeatHold",
+			Handler:    _BookingService_CreateSeatHold_Handler,
+		},
+		{
+			MethodName: "ExtendSeatHold",
+			Handler:    _BookingService_ExtendSeatHold_Handler,
+		},
+		{
+			MethodName: "ReleaseSeatHold",
+			Handler:    _BookingService_ReleaseSeatHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "booking.proto",
+}
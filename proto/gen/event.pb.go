@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -30,10 +31,12 @@ type CreateEventRequest struct {
 	EventDate        string                 `protobuf:"bytes,5,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
 	EventStartTime   string                 `protobuf:"bytes,6,opt,name=event_start_time,json=eventStartTime,proto3" json:"event_start_time,omitempty"`
 	EventEndTime     string                 `protobuf:"bytes,7,opt,name=event_end_time,json=eventEndTime,proto3" json:"event_end_time,omitempty"`
-	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	TotalSlots       int32                  `protobuf:"varint,9,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Kinds of tickets on sale; without any, bookings are free general
+	// admission. tier_id and available are assigned by the server.
+	TicketTiers   []*TicketTier `protobuf:"bytes,10,rep,name=ticket_tiers,json=ticketTiers,proto3" json:"ticket_tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateEventRequest) Reset() {
@@ -108,24 +111,25 @@ func (x *CreateEventRequest) GetEventEndTime() string {
 	return ""
 }
 
-func (x *CreateEventRequest) GetCreatedBy() string {
+func (x *CreateEventRequest) GetTotalSlots() int32 {
 	if x != nil {
-		return x.CreatedBy
+		return x.TotalSlots
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateEventRequest) GetTotalSlots() int32 {
+func (x *CreateEventRequest) GetTicketTiers() []*TicketTier {
 	if x != nil {
-		return x.TotalSlots
+		return x.TicketTiers
 	}
-	return 0
+	return nil
 }
 
 type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketTiers   []*TicketTier          `protobuf:"bytes,3,rep,name=ticket_tiers,json=ticketTiers,proto3" json:"ticket_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,6 +178,127 @@ func (x *CreateEventResponse) GetEventId() string {
 	return ""
 }
 
+func (x *CreateEventResponse) GetTicketTiers() []*TicketTier {
+	if x != nil {
+		return x.TicketTiers
+	}
+	return nil
+}
+
+// A kind of ticket for an event. Its capacity is counted on top of the
+// event's total_slots: a tier sells out when either runs out.
+type TicketTier struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TierId string                 `protobuf:"bytes,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// In the currency's minor unit, e.g. cents; 0 for free tickets
+	PriceMinor int64 `protobuf:"varint,3,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"`
+	// ISO 4217 code such as USD; may be empty for free tiers
+	Currency  string `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Capacity  int32  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Available int32  `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	// RFC3339; empty for no limit
+	SalesStart string `protobuf:"bytes,7,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd   string `protobuf:"bytes,8,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	// Most tickets of this tier in one booking; 0 for no limit
+	MaxPerOrder   int32 `protobuf:"varint,9,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketTier) Reset() {
+	*x = TicketTier{}
+	mi := &file_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketTier) ProtoMessage() {}
+
+func (x *TicketTier) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketTier.ProtoReflect.Descriptor instead.
+func (*TicketTier) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TicketTier) GetTierId() string {
+	if x != nil {
+		return x.TierId
+	}
+	return ""
+}
+
+func (x *TicketTier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketTier) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *TicketTier) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TicketTier) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TicketTier) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *TicketTier) GetSalesStart() string {
+	if x != nil {
+		return x.SalesStart
+	}
+	return ""
+}
+
+func (x *TicketTier) GetSalesEnd() string {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return ""
+}
+
+func (x *TicketTier) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
 type GetEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -183,7 +308,7 @@ type GetEventRequest struct {
 
 func (x *GetEventRequest) Reset() {
 	*x = GetEventRequest{}
-	mi := &file_event_proto_msgTypes[2]
+	mi := &file_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +320,7 @@ func (x *GetEventRequest) String() string {
 func (*GetEventRequest) ProtoMessage() {}
 
 func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[2]
+	mi := &file_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +333,7 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
 func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{2}
+	return file_event_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetEventRequest) GetEventId() string {
@@ -229,13 +354,21 @@ type GetEventResponse struct {
 	EventEndTime     string                 `protobuf:"bytes,7,opt,name=event_end_time,json=eventEndTime,proto3" json:"event_end_time,omitempty"`
 	CreatedBy        string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	TotalSlots       int32                  `protobuf:"varint,9,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	AvailableSlots   int32                  `protobuf:"varint,10,opt,name=available_slots,json=availableSlots,proto3" json:"available_slots,omitempty"`
+	// Incremented on every update; send it back in UpdateEvent
+	Version int32 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// One of draft, published, cancelled, completed
+	Status        string        `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	CancelReason  string        `protobuf:"bytes,13,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	OrgId         string        `protobuf:"bytes,14,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	TicketTiers   []*TicketTier `protobuf:"bytes,15,rep,name=ticket_tiers,json=ticketTiers,proto3" json:"ticket_tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEventResponse) Reset() {
 	*x = GetEventResponse{}
-	mi := &file_event_proto_msgTypes[3]
+	mi := &file_event_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -247,7 +380,7 @@ func (x *GetEventResponse) String() string {
 func (*GetEventResponse) ProtoMessage() {}
 
 func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[3]
+	mi := &file_event_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -260,7 +393,7 @@ func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
 func (*GetEventResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{3}
+	return file_event_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetEventResponse) GetEventId() string {
@@ -326,17 +459,76 @@ func (x *GetEventResponse) GetTotalSlots() int32 {
 	return 0
 }
 
+func (x *GetEventResponse) GetAvailableSlots() int32 {
+	if x != nil {
+		return x.AvailableSlots
+	}
+	return 0
+}
+
+func (x *GetEventResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetEventResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetEventResponse) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *GetEventResponse) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *GetEventResponse) GetTicketTiers() []*TicketTier {
+	if x != nil {
+		return x.TicketTiers
+	}
+	return nil
+}
+
 type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filters; empty values are ignored. Dates are YYYY-MM-DD and inclusive.
+	StartDate    string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate      string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Location     string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	CreatedBy    string `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	HasFreeSlots bool   `protobuf:"varint,7,opt,name=has_free_slots,json=hasFreeSlots,proto3" json:"has_free_slots,omitempty"`
+	// sort_by is one of "date" (default), "created_at" or "title";
+	// sort_order is "asc" (default) or "desc".
+	SortBy    string `protobuf:"bytes,8,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder string `protobuf:"bytes,9,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Cursor returned as next_page_token by a previous call. When set, page is
+	// ignored and results continue after the last event of that call, whose
+	// sort_by and sort_order must be repeated.
+	PageToken string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only return events in this state. Organizers see their own drafts;
+	// admins see every draft.
+	Status        string `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventsRequest) Reset() {
 	*x = ListEventsRequest{}
-	mi := &file_event_proto_msgTypes[4]
+	mi := &file_event_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -348,7 +540,7 @@ func (x *ListEventsRequest) String() string {
 func (*ListEventsRequest) ProtoMessage() {}
 
 func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[4]
+	mi := &file_event_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,7 +553,7 @@ func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
 func (*ListEventsRequest) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{4}
+	return file_event_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListEventsRequest) GetPage() int32 {
@@ -378,17 +570,81 @@ func (x *ListEventsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListEventsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetHasFreeSlots() bool {
+	if x != nil {
+		return x.HasFreeSlots
+	}
+	return false
+}
+
+func (x *ListEventsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type ListEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*GetEventResponse    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEventsResponse) Reset() {
 	*x = ListEventsResponse{}
-	mi := &file_event_proto_msgTypes[5]
+	mi := &file_event_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +656,7 @@ func (x *ListEventsResponse) String() string {
 func (*ListEventsResponse) ProtoMessage() {}
 
 func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[5]
+	mi := &file_event_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +669,7 @@ func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
 func (*ListEventsResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{5}
+	return file_event_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListEventsResponse) GetEvents() []*GetEventResponse {
@@ -430,55 +686,1529 @@ func (x *ListEventsResponse) GetTotal() int32 {
 	return 0
 }
 
-var File_event_proto protoreflect.FileDescriptor
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
 
-const file_event_proto_rawDesc = "" +
-	"\n" +
-	"\vevent.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\"\xb8\x02\n" +
-	"\x12CreateEventRequest\x12\x1f\n" +
-	"\vevent_title\x18\x02 \x01(\tR\n" +
-	"eventTitle\x12+\n" +
-	"\x11event_description\x18\x03 \x01(\tR\x10eventDescription\x12%\n" +
-	"\x0eevent_location\x18\x04 \x01(\tR\reventLocation\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x05 \x01(\tR\teventDate\x12(\n" +
-	"\x10event_start_time\x18\x06 \x01(\tR\x0eeventStartTime\x12$\n" +
-	"\x0eevent_end_time\x18\a \x01(\tR\feventEndTime\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1f\n" +
-	"\vtotal_slots\x18\t \x01(\x05R\n" +
-	"totalSlots\"J\n" +
-	"\x13CreateEventResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\",\n" +
-	"\x0fGetEventRequest\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xd1\x02\n" +
-	"\x10GetEventResponse\x12\x19\n" +
-	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
-	"\vevent_title\x18\x02 \x01(\tR\n" +
-	"eventTitle\x12+\n" +
-	"\x11event_description\x18\x03 \x01(\tR\x10eventDescription\x12%\n" +
-	"\x0eevent_location\x18\x04 \x01(\tR\reventLocation\x12\x1d\n" +
-	"\n" +
-	"event_date\x18\x05 \x01(\tR\teventDate\x12(\n" +
-	"\x10event_start_time\x18\x06 \x01(\tR\x0eeventStartTime\x12$\n" +
-	"\x0eevent_end_time\x18\a \x01(\tR\feventEndTime\x12\x1d\n" +
-	"\n" +
-	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1f\n" +
-	"\vtotal_slots\x18\t \x01(\x05R\n" +
-	"totalSlots\"=\n" +
-	"\x11ListEventsRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"[\n" +
-	"\x12ListEventsResponse\x12/\n" +
-	"\x06events\x18\x01 \x03(\v2\x17.event.GetEventResponseR\x06events\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\xab\x02\n" +
+type EventUpdate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EventTitle       string                 `protobuf:"bytes,1,opt,name=event_title,json=eventTitle,proto3" json:"event_title,omitempty"`
+	EventDescription string                 `protobuf:"bytes,2,opt,name=event_description,json=eventDescription,proto3" json:"event_description,omitempty"`
+	EventLocation    string                 `protobuf:"bytes,3,opt,name=event_location,json=eventLocation,proto3" json:"event_location,omitempty"`
+	EventDate        string                 `protobuf:"bytes,4,opt,name=event_date,json=eventDate,proto3" json:"event_date,omitempty"`
+	EventStartTime   string                 `protobuf:"bytes,5,opt,name=event_start_time,json=eventStartTime,proto3" json:"event_start_time,omitempty"`
+	EventEndTime     string                 `protobuf:"bytes,6,opt,name=event_end_time,json=eventEndTime,proto3" json:"event_end_time,omitempty"`
+	TotalSlots       int32                  `protobuf:"varint,7,opt,name=total_slots,json=totalSlots,proto3" json:"total_slots,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *EventUpdate) Reset() {
+	*x = EventUpdate{}
+	mi := &file_event_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdate) ProtoMessage() {}
+
+func (x *EventUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventUpdate.ProtoReflect.Descriptor instead.
+func (*EventUpdate) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventUpdate) GetEventTitle() string {
+	if x != nil {
+		return x.EventTitle
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetEventDescription() string {
+	if x != nil {
+		return x.EventDescription
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetEventLocation() string {
+	if x != nil {
+		return x.EventLocation
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetEventDate() string {
+	if x != nil {
+		return x.EventDate
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetEventStartTime() string {
+	if x != nil {
+		return x.EventStartTime
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetEventEndTime() string {
+	if x != nil {
+		return x.EventEndTime
+	}
+	return ""
+}
+
+func (x *EventUpdate) GetTotalSlots() int32 {
+	if x != nil {
+		return x.TotalSlots
+	}
+	return 0
+}
+
+type UpdateEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Event   *EventUpdate           `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Paths in event to change, e.g. "event_title,total_slots". When empty,
+	// every non-empty field of event is applied.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Version the change is based on; a stale version fails with ABORTED.
+	Version       int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEvent() *EventUpdate {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Event         *GetEventResponse      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventResponse) Reset() {
+	*x = UpdateEventResponse{}
+	mi := &file_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventResponse) ProtoMessage() {}
+
+func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateEventResponse) GetEvent() *GetEventResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type DeleteEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Delete even if the event still has confirmed bookings
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventRequest) Reset() {
+	*x = DeleteEventRequest{}
+	mi := &file_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventRequest) ProtoMessage() {}
+
+func (x *DeleteEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DeleteEventRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEventResponse) Reset() {
+	*x = DeleteEventResponse{}
+	mi := &file_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEventResponse) ProtoMessage() {}
+
+func (x *DeleteEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEventResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PublishEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEventRequest) Reset() {
+	*x = PublishEventRequest{}
+	mi := &file_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventRequest) ProtoMessage() {}
+
+func (x *PublishEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventRequest.ProtoReflect.Descriptor instead.
+func (*PublishEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PublishEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type PublishEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Event         *GetEventResponse      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishEventResponse) Reset() {
+	*x = PublishEventResponse{}
+	mi := &file_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishEventResponse) ProtoMessage() {}
+
+func (x *PublishEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishEventResponse.ProtoReflect.Descriptor instead.
+func (*PublishEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublishEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishEventResponse) GetEvent() *GetEventResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CancelEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRequest) Reset() {
+	*x = CancelEventRequest{}
+	mi := &file_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRequest) ProtoMessage() {}
+
+func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CancelEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelEventResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Message           string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Event             *GetEventResponse      `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	CancelledBookings int32                  `protobuf:"varint,3,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelEventResponse) Reset() {
+	*x = CancelEventResponse{}
+	mi := &file_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventResponse) ProtoMessage() {}
+
+func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CancelEventResponse) GetEvent() *GetEventResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CancelEventResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+type ListMyEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional state filter: draft, published, cancelled or completed.
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyEventsRequest) Reset() {
+	*x = ListMyEventsRequest{}
+	mi := &file_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyEventsRequest) ProtoMessage() {}
+
+func (x *ListMyEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMyEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMyEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMyEventsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListEventAttendeesRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Page    int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit   int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Optional status filter: "confirmed", "pending_payment", "cancelled"
+	// or "expired".
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAttendeesRequest) Reset() {
+	*x = ListEventAttendeesRequest{}
+	mi := &file_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAttendeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendeesRequest) ProtoMessage() {}
+
+func (x *ListEventAttendeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendeesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventAttendeesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEventAttendeesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListEventAttendeesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEventAttendeesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventAttendeesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Attendee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	BookedAt      string                 `protobuf:"bytes,8,opt,name=booked_at,json=bookedAt,proto3" json:"booked_at,omitempty"`
+	CheckedInAt   string                 `protobuf:"bytes,9,opt,name=checked_in_at,json=checkedInAt,proto3" json:"checked_in_at,omitempty"`
+	TierName      string                 `protobuf:"bytes,10,opt,name=tier_name,json=tierName,proto3" json:"tier_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendee) Reset() {
+	*x = Attendee{}
+	mi := &file_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendee) ProtoMessage() {}
+
+func (x *Attendee) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendee.ProtoReflect.Descriptor instead.
+func (*Attendee) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Attendee) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *Attendee) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Attendee) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Attendee) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Attendee) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Attendee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Attendee) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Attendee) GetBookedAt() string {
+	if x != nil {
+		return x.BookedAt
+	}
+	return ""
+}
+
+func (x *Attendee) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *Attendee) GetTierName() string {
+	if x != nil {
+		return x.TierName
+	}
+	return ""
+}
+
+func (x *Attendee) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListEventAttendeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attendees     []*Attendee            `protobuf:"bytes,1,rep,name=attendees,proto3" json:"attendees,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventAttendeesResponse) Reset() {
+	*x = ListEventAttendeesResponse{}
+	mi := &file_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventAttendeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventAttendeesResponse) ProtoMessage() {}
+
+func (x *ListEventAttendeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventAttendeesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventAttendeesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListEventAttendeesResponse) GetAttendees() []*Attendee {
+	if x != nil {
+		return x.Attendees
+	}
+	return nil
+}
+
+func (x *ListEventAttendeesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type EventMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email    string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	// One of owner, co_organizer, checkin_staff, viewer
+	Role      string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	InvitedBy string `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	InvitedAt string `protobuf:"bytes,6,opt,name=invited_at,json=invitedAt,proto3" json:"invited_at,omitempty"`
+	// Empty while the invitation is pending
+	AcceptedAt    string `protobuf:"bytes,7,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventMember) Reset() {
+	*x = EventMember{}
+	mi := &file_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMember) ProtoMessage() {}
+
+func (x *EventMember) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMember.ProtoReflect.Descriptor instead.
+func (*EventMember) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EventMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EventMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *EventMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *EventMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *EventMember) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *EventMember) GetInvitedAt() string {
+	if x != nil {
+		return x.InvitedAt
+	}
+	return ""
+}
+
+func (x *EventMember) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
+}
+
+type InviteEventMemberRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The user to invite, by username or email
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteEventMemberRequest) Reset() {
+	*x = InviteEventMemberRequest{}
+	mi := &file_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteEventMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteEventMemberRequest) ProtoMessage() {}
+
+func (x *InviteEventMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteEventMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteEventMemberRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InviteEventMemberRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *InviteEventMemberRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *InviteEventMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteEventMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type InviteEventMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Member        *EventMember           `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteEventMemberResponse) Reset() {
+	*x = InviteEventMemberResponse{}
+	mi := &file_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteEventMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteEventMemberResponse) ProtoMessage() {}
+
+func (x *InviteEventMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteEventMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteEventMemberResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InviteEventMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InviteEventMemberResponse) GetMember() *EventMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type AcceptEventInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptEventInviteRequest) Reset() {
+	*x = AcceptEventInviteRequest{}
+	mi := &file_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptEventInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptEventInviteRequest) ProtoMessage() {}
+
+func (x *AcceptEventInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptEventInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptEventInviteRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AcceptEventInviteRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type AcceptEventInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Member        *EventMember           `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptEventInviteResponse) Reset() {
+	*x = AcceptEventInviteResponse{}
+	mi := &file_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptEventInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptEventInviteResponse) ProtoMessage() {}
+
+func (x *AcceptEventInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptEventInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptEventInviteResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AcceptEventInviteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AcceptEventInviteResponse) GetMember() *EventMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveEventMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEventMemberRequest) Reset() {
+	*x = RemoveEventMemberRequest{}
+	mi := &file_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEventMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEventMemberRequest) ProtoMessage() {}
+
+func (x *RemoveEventMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEventMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEventMemberRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveEventMemberRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RemoveEventMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveEventMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveEventMemberResponse) Reset() {
+	*x = RemoveEventMemberResponse{}
+	mi := &file_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveEventMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEventMemberResponse) ProtoMessage() {}
+
+func (x *RemoveEventMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEventMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveEventMemberResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveEventMemberResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListEventMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventMembersRequest) Reset() {
+	*x = ListEventMembersRequest{}
+	mi := &file_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventMembersRequest) ProtoMessage() {}
+
+func (x *ListEventMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListEventMembersRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListEventMembersRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListEventMembersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The creator first, as owner, then everyone invited
+	Members       []*EventMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventMembersResponse) Reset() {
+	*x = ListEventMembersResponse{}
+	mi := &file_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventMembersResponse) ProtoMessage() {}
+
+func (x *ListEventMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListEventMembersResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListEventMembersResponse) GetMembers() []*EventMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+var File_event_proto protoreflect.FileDescriptor
+
+const file_event_proto_rawDesc = "" +
+	"\n" +
+	"\vevent.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"\xe1\x02\n" +
+	"\x12CreateEventRequest\x12\x1f\n" +
+	"\vevent_title\x18\x02 \x01(\tR\n" +
+	"eventTitle\x12+\n" +
+	"\x11event_description\x18\x03 \x01(\tR\x10eventDescription\x12%\n" +
+	"\x0eevent_location\x18\x04 \x01(\tR\reventLocation\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x05 \x01(\tR\teventDate\x12(\n" +
+	"\x10event_start_time\x18\x06 \x01(\tR\x0eeventStartTime\x12$\n" +
+	"\x0eevent_end_time\x18\a \x01(\tR\feventEndTime\x12\x1f\n" +
+	"\vtotal_slots\x18\t \x01(\x05R\n" +
+	"totalSlots\x124\n" +
+	"\fticket_tiers\x18\n" +
+	" \x03(\v2\x11.event.TicketTierR\vticketTiersJ\x04\b\b\x10\tR\n" +
+	"created_by\"\x80\x01\n" +
+	"\x13CreateEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x124\n" +
+	"\fticket_tiers\x18\x03 \x03(\v2\x11.event.TicketTierR\vticketTiers\"\x92\x02\n" +
+	"\n" +
+	"TicketTier\x12\x17\n" +
+	"\atier_id\x18\x01 \x01(\tR\x06tierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_minor\x18\x03 \x01(\x03R\n" +
+	"priceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\x05R\tavailable\x12\x1f\n" +
+	"\vsales_start\x18\a \x01(\tR\n" +
+	"salesStart\x12\x1b\n" +
+	"\tsales_end\x18\b \x01(\tR\bsalesEnd\x12\"\n" +
+	"\rmax_per_order\x18\t \x01(\x05R\vmaxPerOrder\",\n" +
+	"\x0fGetEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x9e\x04\n" +
+	"\x10GetEventResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vevent_title\x18\x02 \x01(\tR\n" +
+	"eventTitle\x12+\n" +
+	"\x11event_description\x18\x03 \x01(\tR\x10eventDescription\x12%\n" +
+	"\x0eevent_location\x18\x04 \x01(\tR\reventLocation\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x05 \x01(\tR\teventDate\x12(\n" +
+	"\x10event_start_time\x18\x06 \x01(\tR\x0eeventStartTime\x12$\n" +
+	"\x0eevent_end_time\x18\a \x01(\tR\feventEndTime\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vtotal_slots\x18\t \x01(\x05R\n" +
+	"totalSlots\x12'\n" +
+	"\x0favailable_slots\x18\n" +
+	" \x01(\x05R\x0eavailableSlots\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12#\n" +
+	"\rcancel_reason\x18\r \x01(\tR\fcancelReason\x12\x15\n" +
+	"\x06org_id\x18\x0e \x01(\tR\x05orgId\x124\n" +
+	"\fticket_tiers\x18\x0f \x03(\v2\x11.event.TicketTierR\vticketTiers\"\xc7\x02\n" +
+	"\x11ListEventsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12$\n" +
+	"\x0ehas_free_slots\x18\a \x01(\bR\fhasFreeSlots\x12\x17\n" +
+	"\asort_by\x18\b \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\t \x01(\tR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\"\x83\x01\n" +
+	"\x12ListEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.event.GetEventResponseR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x92\x02\n" +
+	"\vEventUpdate\x12\x1f\n" +
+	"\vevent_title\x18\x01 \x01(\tR\n" +
+	"eventTitle\x12+\n" +
+	"\x11event_description\x18\x02 \x01(\tR\x10eventDescription\x12%\n" +
+	"\x0eevent_location\x18\x03 \x01(\tR\reventLocation\x12\x1d\n" +
+	"\n" +
+	"event_date\x18\x04 \x01(\tR\teventDate\x12(\n" +
+	"\x10event_start_time\x18\x05 \x01(\tR\x0eeventStartTime\x12$\n" +
+	"\x0eevent_end_time\x18\x06 \x01(\tR\feventEndTime\x12\x1f\n" +
+	"\vtotal_slots\x18\a \x01(\x05R\n" +
+	"totalSlots\"\xb0\x01\n" +
+	"\x12UpdateEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12(\n" +
+	"\x05event\x18\x02 \x01(\v2\x12.event.EventUpdateR\x05event\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\"^\n" +
+	"\x13UpdateEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12-\n" +
+	"\x05event\x18\x02 \x01(\v2\x17.event.GetEventResponseR\x05event\"E\n" +
+	"\x12DeleteEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"/\n" +
+	"\x13DeleteEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"0\n" +
+	"\x13PublishEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"_\n" +
+	"\x14PublishEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12-\n" +
+	"\x05event\x18\x02 \x01(\v2\x17.event.GetEventResponseR\x05event\"G\n" +
+	"\x12CancelEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x8d\x01\n" +
+	"\x13CancelEventResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12-\n" +
+	"\x05event\x18\x02 \x01(\v2\x17.event.GetEventResponseR\x05event\x12-\n" +
+	"\x12cancelled_bookings\x18\x03 \x01(\x05R\x11cancelledBookings\"W\n" +
+	"\x13ListMyEventsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"x\n" +
+	"\x19ListEventAttendeesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xc2\x02\n" +
+	"\bAttendee\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1b\n" +
+	"\tbooked_at\x18\b \x01(\tR\bbookedAt\x12\"\n" +
+	"\rchecked_in_at\x18\t \x01(\tR\vcheckedInAt\x12\x1b\n" +
+	"\ttier_name\x18\n" +
+	" \x01(\tR\btierName\x12\x1a\n" +
+	"\bquantity\x18\v \x01(\x05R\bquantity\"a\n" +
+	"\x1aListEventAttendeesResponse\x12-\n" +
+	"\tattendees\x18\x01 \x03(\v2\x0f.event.AttendeeR\tattendees\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xcb\x01\n" +
+	"\vEventMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x05 \x01(\tR\tinvitedBy\x12\x1d\n" +
+	"\n" +
+	"invited_at\x18\x06 \x01(\tR\tinvitedAt\x12\x1f\n" +
+	"\vaccepted_at\x18\a \x01(\tR\n" +
+	"acceptedAt\"{\n" +
+	"\x18InviteEventMemberRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"a\n" +
+	"\x19InviteEventMemberResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12*\n" +
+	"\x06member\x18\x02 \x01(\v2\x12.event.EventMemberR\x06member\"5\n" +
+	"\x18AcceptEventInviteRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"a\n" +
+	"\x19AcceptEventInviteResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12*\n" +
+	"\x06member\x18\x02 \x01(\v2\x12.event.EventMemberR\x06member\"N\n" +
+	"\x18RemoveEventMemberRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
+	"\x19RemoveEventMemberResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"4\n" +
+	"\x17ListEventMembersRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"H\n" +
+	"\x18ListEventMembersResponse\x12,\n" +
+	"\amembers\x18\x01 \x03(\v2\x12.event.EventMemberR\amembers2\xd7\v\n" +
 	"\fEventService\x12a\n" +
 	"\vCreateEvent\x12\x19.event.CreateEventRequest\x1a\x1a.event.CreateEventResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/event/create\x12a\n" +
 	"\x0fGetEventDetails\x12\x16.event.GetEventRequest\x1a\x17.event.GetEventResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/events/{event_id}\x12U\n" +
 	"\n" +
 	"ListEvents\x12\x18.event.ListEventsRequest\x1a\x19.event.ListEventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
-	"/v1/eventsB\aZ\x05./genb\x06proto3"
+	"/v1/events\x12f\n" +
+	"\vUpdateEvent\x12\x19.event.UpdateEventRequest\x1a\x1a.event.UpdateEventResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/events/{event_id}\x12c\n" +
+	"\vDeleteEvent\x12\x19.event.DeleteEventRequest\x1a\x1a.event.DeleteEventResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/events/{event_id}\x12q\n" +
+	"\fPublishEvent\x12\x1a.event.PublishEventRequest\x1a\x1b.event.PublishEventResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/events/{event_id}/publish\x12m\n" +
+	"\vCancelEvent\x12\x19.event.CancelEventRequest\x1a\x1a.event.CancelEventResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/events/{event_id}/cancel\x12c\n" +
+	"\fListMyEvents\x12\x1a.event.ListMyEventsRequest\x1a\x19.event.ListEventsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/organizer/events\x12\x82\x01\n" +
+	"\x12ListEventAttendees\x12 .event.ListEventAttendeesRequest\x1a!.event.ListEventAttendeesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/events/{event_id}/attendees\x12\x80\x01\n" +
+	"\x11InviteEventMember\x12\x1f.event.InviteEventMemberRequest\x1a .event.InviteEventMemberResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/events/{event_id}/members\x12\x87\x01\n" +
+	"\x11AcceptEventInvite\x12\x1f.event.AcceptEventInviteRequest\x1a .event.AcceptEventInviteResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/events/{event_id}/members/accept\x12\x87\x01\n" +
+	"\x11RemoveEventMember\x12\x1f.event.RemoveEventMemberRequest\x1a .event.RemoveEventMemberResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/events/{event_id}/members/{user_id}\x12z\n" +
+	"\x10ListEventMembers\x12\x1e.event.ListEventMembersRequest\x1a\x1f.event.ListEventMembersResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/events/{event_id}/membersB\aZ\x05./genb\x06proto3"
 
 var (
 	file_event_proto_rawDescOnce sync.Once
@@ -492,28 +2222,84 @@ func file_event_proto_rawDescGZIP() []byte {
 	return file_event_proto_rawDescData
 }
 
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_event_proto_goTypes = []any{
-	(*CreateEventRequest)(nil),  // 0: event.CreateEventRequest
-	(*CreateEventResponse)(nil), // 1: event.CreateEventResponse
-	(*GetEventRequest)(nil),     // 2: event.GetEventRequest
-	(*GetEventResponse)(nil),    // 3: event.GetEventResponse
-	(*ListEventsRequest)(nil),   // 4: event.ListEventsRequest
-	(*ListEventsResponse)(nil),  // 5: event.ListEventsResponse
+	(*CreateEventRequest)(nil),         // 0: event.CreateEventRequest
+	(*CreateEventResponse)(nil),        // 1: event.CreateEventResponse
+	(*TicketTier)(nil),                 // 2: event.TicketTier
+	(*GetEventRequest)(nil),            // 3: event.GetEventRequest
+	(*GetEventResponse)(nil),           // 4: event.GetEventResponse
+	(*ListEventsRequest)(nil),          // 5: event.ListEventsRequest
+	(*ListEventsResponse)(nil),         // 6: event.ListEventsResponse
+	(*EventUpdate)(nil),                // 7: event.EventUpdate
+	(*UpdateEventRequest)(nil),         // 8: event.UpdateEventRequest
+	(*UpdateEventResponse)(nil),        // 9: event.UpdateEventResponse
+	(*DeleteEventRequest)(nil),         // 10: event.DeleteEventRequest
+	(*DeleteEventResponse)(nil),        // 11: event.DeleteEventResponse
+	(*PublishEventRequest)(nil),        // 12: event.PublishEventRequest
+	(*PublishEventResponse)(nil),       // 13: event.PublishEventResponse
+	(*CancelEventRequest)(nil),         // 14: event.CancelEventRequest
+	(*CancelEventResponse)(nil),        // 15: event.CancelEventResponse
+	(*ListMyEventsRequest)(nil),        // 16: event.ListMyEventsRequest
+	(*ListEventAttendeesRequest)(nil),  // 17: event.ListEventAttendeesRequest
+	(*Attendee)(nil),                   // 18: event.Attendee
+	(*ListEventAttendeesResponse)(nil), // 19: event.ListEventAttendeesResponse
+	(*EventMember)(nil),                // 20: event.EventMember
+	(*InviteEventMemberRequest)(nil),   // 21: event.InviteEventMemberRequest
+	(*InviteEventMemberResponse)(nil),  // 22: event.InviteEventMemberResponse
+	(*AcceptEventInviteRequest)(nil),   // 23: event.AcceptEventInviteRequest
+	(*AcceptEventInviteResponse)(nil),  // 24: event.AcceptEventInviteResponse
+	(*RemoveEventMemberRequest)(nil),   // 25: event.RemoveEventMemberRequest
+	(*RemoveEventMemberResponse)(nil),  // 26: event.RemoveEventMemberResponse
+	(*ListEventMembersRequest)(nil),    // 27: event.ListEventMembersRequest
+	(*ListEventMembersResponse)(nil),   // 28: event.ListEventMembersResponse
+	(*fieldmaskpb.FieldMask)(nil),      // 29: google.protobuf.FieldMask
 }
 var file_event_proto_depIdxs = []int32{
-	3, // 0: event.ListEventsResponse.events:type_name -> event.GetEventResponse
-	0, // 1: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
-	2, // 2: event.EventService.GetEventDetails:input_type -> event.GetEventRequest
-	4, // 3: event.EventService.ListEvents:input_type -> event.ListEventsRequest
-	1, // 4: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
-	3, // 5: event.EventService.GetEventDetails:output_type -> event.GetEventResponse
-	5, // 6: event.EventService.ListEvents:output_type -> event.ListEventsResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: event.CreateEventRequest.ticket_tiers:type_name -> event.TicketTier
+	2,  // 1: event.CreateEventResponse.ticket_tiers:type_name -> event.TicketTier
+	2,  // 2: event.GetEventResponse.ticket_tiers:type_name -> event.TicketTier
+	4,  // 3: event.ListEventsResponse.events:type_name -> event.GetEventResponse
+	7,  // 4: event.UpdateEventRequest.event:type_name -> event.EventUpdate
+	29, // 5: event.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 6: event.UpdateEventResponse.event:type_name -> event.GetEventResponse
+	4,  // 7: event.PublishEventResponse.event:type_name -> event.GetEventResponse
+	4,  // 8: event.CancelEventResponse.event:type_name -> event.GetEventResponse
+	18, // 9: event.ListEventAttendeesResponse.attendees:type_name -> event.Attendee
+	20, // 10: event.InviteEventMemberResponse.member:type_name -> event.EventMember
+	20, // 11: event.AcceptEventInviteResponse.member:type_name -> event.EventMember
+	20, // 12: event.ListEventMembersResponse.members:type_name -> event.EventMember
+	0,  // 13: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
+	3,  // 14: event.EventService.GetEventDetails:input_type -> event.GetEventRequest
+	5,  // 15: event.EventService.ListEvents:input_type -> event.ListEventsRequest
+	8,  // 16: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
+	10, // 17: event.EventService.DeleteEvent:input_type -> event.DeleteEventRequest
+	12, // 18: event.EventService.PublishEvent:input_type -> event.PublishEventRequest
+	14, // 19: event.EventService.CancelEvent:input_type -> event.CancelEventRequest
+	16, // 20: event.EventService.ListMyEvents:input_type -> event.ListMyEventsRequest
+	17, // 21: event.EventService.ListEventAttendees:input_type -> event.ListEventAttendeesRequest
+	21, // 22: event.EventService.InviteEventMember:input_type -> event.InviteEventMemberRequest
+	23, // 23: event.EventService.AcceptEventInvite:input_type -> event.AcceptEventInviteRequest
+	25, // 24: event.EventService.RemoveEventMember:input_type -> event.RemoveEventMemberRequest
+	27, // 25: event.EventService.ListEventMembers:input_type -> event.ListEventMembersRequest
+	1,  // 26: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
+	4,  // 27: event.EventService.GetEventDetails:output_type -> event.GetEventResponse
+	6,  // 28: event.EventService.ListEvents:output_type -> event.ListEventsResponse
+	9,  // 29: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
+	11, // 30: event.EventService.DeleteEvent:output_type -> event.DeleteEventResponse
+	13, // 31: event.EventService.PublishEvent:output_type -> event.PublishEventResponse
+	15, // 32: event.EventService.CancelEvent:output_type -> event.CancelEventResponse
+	6,  // 33: event.EventService.ListMyEvents:output_type -> event.ListEventsResponse
+	19, // 34: event.EventService.ListEventAttendees:output_type -> event.ListEventAttendeesResponse
+	22, // 35: event.EventService.InviteEventMember:output_type -> event.InviteEventMemberResponse
+	24, // 36: event.EventService.AcceptEventInvite:output_type -> event.AcceptEventInviteResponse
+	26, // 37: event.EventService.RemoveEventMember:output_type -> event.RemoveEventMemberResponse
+	28, // 38: event.EventService.ListEventMembers:output_type -> event.ListEventMembersResponse
+	26, // [26:39] is the sub-list for method output_type
+	13, // [13:26] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -527,7 +2313,7 @@ func file_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_CreateEvent_FullMethodName     = "/event.EventService/CreateEvent"
-	EventService_GetEventDetails_FullMethodName = "/event.EventService/GetEventDetails"
-	EventService_ListEvents_FullMethodName      = "/event.EventService/ListEvents"
+	EventService_CreateEvent_FullMethodName        = "/event.EventService/CreateEvent"
+	EventService_GetEventDetails_FullMethodName    = "/event.EventService/GetEventDetails"
+	EventService_ListEvents_FullMethodName         = "/event.EventService/ListEvents"
+	EventService_UpdateEvent_FullMethodName        = "/event.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName        = "/event.EventService/DeleteEvent"
+	EventService_PublishEvent_FullMethodName       = "/event.EventService/PublishEvent"
+	EventService_CancelEvent_FullMethodName        = "/event.EventService/CancelEvent"
+	EventService_ListMyEvents_FullMethodName       = "/event.EventService/ListMyEvents"
+	EventService_ListEventAttendees_FullMethodName = "/event.EventService/ListEventAttendees"
+	EventService_InviteEventMember_FullMethodName  = "/event.EventService/InviteEventMember"
+	EventService_AcceptEventInvite_FullMethodName  = "/event.EventService/AcceptEventInvite"
+	EventService_RemoveEventMember_FullMethodName  = "/event.EventService/RemoveEventMember"
+	EventService_ListEventMembers_FullMethodName   = "/event.EventService/ListEventMembers"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -31,6 +41,23 @@ type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
 	GetEventDetails(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error)
+	DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error)
+	// Move a draft event to published so customers can see and book it
+	PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error)
+	// Cancel an event and all of its bookings, refunding paid ones
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error)
+	// Events the caller created or is a member of, drafts included
+	ListMyEvents(ctx context.Context, in *ListMyEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// Bookings for an event; visible to its members and admins
+	ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*ListEventAttendeesResponse, error)
+	// Event team. Owners manage every member; co-organizers may add and
+	// remove check-in staff and viewers.
+	InviteEventMember(ctx context.Context, in *InviteEventMemberRequest, opts ...grpc.CallOption) (*InviteEventMemberResponse, error)
+	AcceptEventInvite(ctx context.Context, in *AcceptEventInviteRequest, opts ...grpc.CallOption) (*AcceptEventInviteResponse, error)
+	// Members may also remove themselves, which declines a pending invite
+	RemoveEventMember(ctx context.Context, in *RemoveEventMemberRequest, opts ...grpc.CallOption) (*RemoveEventMemberResponse, error)
+	ListEventMembers(ctx context.Context, in *ListEventMembersRequest, opts ...grpc.CallOption) (*ListEventMembersResponse, error)
 }
 
 type eventServiceClient struct {
@@ -71,6 +98,106 @@ func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsReque
 	return out, nil
 }
 
+func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEventResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *DeleteEventRequest, opts ...grpc.CallOption) (*DeleteEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEventResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) PublishEvent(ctx context.Context, in *PublishEventRequest, opts ...grpc.CallOption) (*PublishEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishEventResponse)
+	err := c.cc.Invoke(ctx, EventService_PublishEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListMyEvents(ctx context.Context, in *ListMyEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListMyEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventAttendees(ctx context.Context, in *ListEventAttendeesRequest, opts ...grpc.CallOption) (*ListEventAttendeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventAttendeesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventAttendees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) InviteEventMember(ctx context.Context, in *InviteEventMemberRequest, opts ...grpc.CallOption) (*InviteEventMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InviteEventMemberResponse)
+	err := c.cc.Invoke(ctx, EventService_InviteEventMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AcceptEventInvite(ctx context.Context, in *AcceptEventInviteRequest, opts ...grpc.CallOption) (*AcceptEventInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptEventInviteResponse)
+	err := c.cc.Invoke(ctx, EventService_AcceptEventInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RemoveEventMember(ctx context.Context, in *RemoveEventMemberRequest, opts ...grpc.CallOption) (*RemoveEventMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveEventMemberResponse)
+	err := c.cc.Invoke(ctx, EventService_RemoveEventMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListEventMembers(ctx context.Context, in *ListEventMembersRequest, opts ...grpc.CallOption) (*ListEventMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventMembersResponse)
+	err := c.cc.Invoke(ctx, EventService_ListEventMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -78,6 +205,23 @@ type EventServiceServer interface {
 	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
 	GetEventDetails(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error)
+	DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error)
+	// Move a draft event to published so customers can see and book it
+	PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error)
+	// Cancel an event and all of its bookings, refunding paid ones
+	CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error)
+	// Events the caller created or is a member of, drafts included
+	ListMyEvents(context.Context, *ListMyEventsRequest) (*ListEventsResponse, error)
+	// Bookings for an event; visible to its members and admins
+	ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*ListEventAttendeesResponse, error)
+	// Event team. Owners manage every member; co-organizers may add and
+	// remove check-in staff and viewers.
+	InviteEventMember(context.Context, *InviteEventMemberRequest) (*InviteEventMemberResponse, error)
+	AcceptEventInvite(context.Context, *AcceptEventInviteRequest) (*AcceptEventInviteResponse, error)
+	// Members may also remove themselves, which declines a pending invite
+	RemoveEventMember(context.Context, *RemoveEventMemberRequest) (*RemoveEventMemberResponse, error)
+	ListEventMembers(context.Context, *ListEventMembersRequest) (*ListEventMembersResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -97,6 +241,36 @@ func (UnimplementedEventServiceServer) GetEventDetails(context.Context, *GetEven
 func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
 }
+func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *DeleteEventRequest) (*DeleteEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
+}
+func (UnimplementedEventServiceServer) PublishEvent(context.Context, *PublishEventRequest) (*PublishEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListMyEvents(context.Context, *ListMyEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyEvents not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventAttendees(context.Context, *ListEventAttendeesRequest) (*ListEventAttendeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventAttendees not implemented")
+}
+func (UnimplementedEventServiceServer) InviteEventMember(context.Context, *InviteEventMemberRequest) (*InviteEventMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteEventMember not implemented")
+}
+func (UnimplementedEventServiceServer) AcceptEventInvite(context.Context, *AcceptEventInviteRequest) (*AcceptEventInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptEventInvite not implemented")
+}
+func (UnimplementedEventServiceServer) RemoveEventMember(context.Context, *RemoveEventMemberRequest) (*RemoveEventMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEventMember not implemented")
+}
+func (UnimplementedEventServiceServer) ListEventMembers(context.Context, *ListEventMembersRequest) (*ListEventMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventMembers not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +346,186 @@ func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteEvent(ctx, req.(*DeleteEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_PublishEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).PublishEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_PublishEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).PublishEvent(ctx, req.(*PublishEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListMyEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListMyEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListMyEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListMyEvents(ctx, req.(*ListMyEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventAttendees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventAttendeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventAttendees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventAttendees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventAttendees(ctx, req.(*ListEventAttendeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_InviteEventMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteEventMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).InviteEventMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_InviteEventMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).InviteEventMember(ctx, req.(*InviteEventMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AcceptEventInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptEventInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AcceptEventInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AcceptEventInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AcceptEventInvite(ctx, req.(*AcceptEventInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RemoveEventMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RemoveEventMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RemoveEventMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RemoveEventMember(ctx, req.(*RemoveEventMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEventMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEventMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEventMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEventMembers(ctx, req.(*ListEventMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +545,46 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _EventService_ListEvents_Handler,
 		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "DeleteEvent",
+			Handler:    _EventService_DeleteEvent_Handler,
+		},
+		{
+			MethodName: "PublishEvent",
+			Handler:    _EventService_PublishEvent_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "ListMyEvents",
+			Handler:    _EventService_ListMyEvents_Handler,
+		},
+		{
+			MethodName: "ListEventAttendees",
+			Handler:    _EventService_ListEventAttendees_Handler,
+		},
+		{
+			MethodName: "InviteEventMember",
+			Handler:    _EventService_InviteEventMember_Handler,
+		},
+		{
+			MethodName: "AcceptEventInvite",
+			Handler:    _EventService_AcceptEventInvite_Handler,
+		},
+		{
+			MethodName: "RemoveEventMember",
+			Handler:    _EventService_RemoveEventMember_Handler,
+		},
+		{
+			MethodName: "ListEventMembers",
+			Handler:    _EventService_ListEventMembers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
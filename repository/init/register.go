@@ -1,17 +1,65 @@
 package repository
 
 import (
+	pgx "eventpass/pgx"
 	intf "eventpass/repository/intf"
+	memory "eventpass/repository/memory"
 
-	"github.com/jmoiron/sqlx"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Repository bundles the storage backends the service handlers depend on.
 type Repository struct {
-	Postgres *PostgresRepo
+	User      intf.UserRepository
+	Event     intf.EventRepository
+	Booking   intf.BookingRepository
+	Session   intf.SessionRepository
+	UserToken intf.UserTokenRepository
+	MFA       intf.MFARepository
+	Throttle  intf.LoginThrottleRepository
+	Member    intf.EventMemberRepository
+	Org       intf.OrganizationRepository
+	APIKey    intf.APIKeyRepository
+	OIDC      intf.OIDCRepository
+	Payment   intf.PaymentRepository
+	Hold      intf.SeatHoldRepository
 }
 
-type PostgresRepo struct {
-	*sqlx.Tx
-	User  intf.UserRepository
-	Event intf.EventRepository
+func NewPostgresRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		User:      pgx.NewUserRepo(db),
+		Event:     pgx.NewEventRepo(db),
+		Booking:   pgx.NewBookingRepo(db),
+		Session:   pgx.NewSessionRepo(db),
+		UserToken: pgx.NewUserTokenRepo(db),
+		MFA:       pgx.NewMFARepo(db),
+		Throttle:  pgx.NewLoginThrottleRepo(db),
+		Member:    pgx.NewEventMemberRepo(db),
+		Org:       pgx.NewOrganizationRepo(db),
+		APIKey:    pgx.NewAPIKeyRepo(db),
+		OIDC:      pgx.NewOIDCRepo(db),
+		Payment:   pgx.NewPaymentRepo(db),
+		Hold:      pgx.NewSeatHoldRepo(db),
+	}
+}
+
+// NewMemoryRepository returns a Repository backed by a single in-memory
+// store, for tests and running without Postgres.
+func NewMemoryRepository() *Repository {
+	store := memory.NewStore()
+	return &Repository{
+		User:      store,
+		Event:     store,
+		Booking:   store,
+		Session:   store,
+		UserToken: store,
+		MFA:       store,
+		Throttle:  store,
+		Member:    store,
+		Org:       store,
+		APIKey:    store,
+		OIDC:      store,
+		Payment:   store,
+		Hold:      store,
+	}
 }
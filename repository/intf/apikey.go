@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key model.APIKey) error
+	GetAPIKey(ctx context.Context, keyID string) (model.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error)
+	// ListAPIKeys returns the keys of ownerID, or every key when it is empty,
+	// newest first
+	ListAPIKeys(ctx context.Context, ownerID string, includeRevoked bool) ([]model.APIKey, error)
+	// RevokeAPIKey fails with FailedPrecondition if the key is already revoked
+	RevokeAPIKey(ctx context.Context, keyID string, at time.Time) error
+	TouchAPIKey(ctx context.Context, keyID string, at time.Time) error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+// BookingRepository methods only see bookings of events in the organization
+// orgID.
+type BookingRepository interface {
+	// CreateBooking must reserve quantity slots of the event and, unless
+	// tierID is empty, of that tier atomically, copying the tier's name and
+	// price onto the booking. It fails with FailedPrecondition when either is
+	// full and NotFound when the tier is not one of the event's. With
+	// expiresAt set the booking is held in pending_payment until then.
+	CreateBooking(ctx context.Context, orgID, bookingID, eventID, userID, tierID string, quantity int, expiresAt *time.Time) error
+	// BookSeatHold turns a seat hold into a booking for its user that keeps
+	// the hold's slots, priced like CreateBooking. It fails with
+	// FailedPrecondition if the hold expired before now or the event is no
+	// longer published.
+	BookSeatHold(ctx context.Context, orgID, bookingID, holdID string, now time.Time, expiresAt *time.Time) error
+	GetBooking(ctx context.Context, orgID, bookingID string) (model.Booking, error)
+	ListBookingsByUser(ctx context.Context, orgID, userID, status string, limit, offset int) ([]model.Booking, int, error)
+	// ListAttendees returns an event's bookings with who made them, oldest
+	// first.
+	ListAttendees(ctx context.Context, orgID, eventID, status string, limit, offset int) ([]model.Attendee, int, error)
+	// CancelBooking cancels a confirmed or pending_payment booking, and its
+	// payment if unpaid, and gives its slots back to the event and its tier.
+	// Bookings that were paid for fail with FailedPrecondition.
+	CancelBooking(ctx context.Context, orgID, bookingID string) error
+	// ExpireBooking is housekeeping and runs across every organization. It
+	// releases a pending_payment booking whose hold ran out before now and
+	// cancels its payment; otherwise it fails with FailedPrecondition.
+	ExpireBooking(ctx context.Context, bookingID string, now time.Time) error
+	// ListAbandonedBookings is housekeeping and runs across every
+	// organization: the IDs of pending_payment bookings whose hold ran out
+	// before now without a payment ever being started, oldest first.
+	ListAbandonedBookings(ctx context.Context, now time.Time, limit int) ([]string, error)
+	// CheckInBooking marks a confirmed booking as attended. It fails with
+	// FailedPrecondition if the booking is not confirmed or already checked in.
+	CheckInBooking(ctx context.Context, orgID, bookingID string, at time.Time) (model.Booking, error)
+}
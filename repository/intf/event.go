@@ -3,9 +3,33 @@ package repository
 import (
 	"context"
 	"eventpass/model"
+	"time"
 )
 
+// EventRepository methods only see events of the organization orgID; other
+// organizations' events are reported as NotFound.
 type EventRepository interface {
-	CreateEvent(ctx context.Context, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy string, totalSlots int32) error
-	GetEvent(ctx context.Context, eventID string) (model.Event, error)
+	// CreateEvent stores the event together with its ticket tiers, if any.
+	CreateEvent(ctx context.Context, orgID, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy string, totalSlots int32, tiers []model.TicketTier) error
+	GetEvent(ctx context.Context, orgID, eventID string) (model.Event, error)
+	// ListTicketTiers returns the tiers of the given events, grouped by event
+	// and in the order they were defined.
+	ListTicketTiers(ctx context.Context, orgID string, eventIDs []string) ([]model.TicketTier, error)
+	ListEvents(ctx context.Context, filter model.EventFilter) ([]model.Event, int, error)
+	// UpdateEvent applies update if the event is still at version. It fails
+	// with Aborted on a version mismatch, FailedPrecondition if total slots
+	// would drop below the booked count, and InvalidArgument if the event
+	// would end before it starts or be moved into the past.
+	UpdateEvent(ctx context.Context, orgID, eventID string, version int, update model.EventUpdate) (model.Event, error)
+	// DeleteEvent removes the event and its bookings. Unless force is set it
+	// fails with FailedPrecondition while confirmed bookings exist, and
+	// always while any booking was paid for, whose payment must be kept.
+	DeleteEvent(ctx context.Context, orgID, eventID string, force bool) error
+	PublishEvent(ctx context.Context, orgID, eventID string) (model.Event, error)
+	// CancelEvent also cancels the event's confirmed bookings and returns how
+	// many there were. Their succeeded payments become refund_pending.
+	CancelEvent(ctx context.Context, orgID, eventID, reason string) (model.Event, int, error)
+	// CompleteFinishedEvents is housekeeping and runs across every
+	// organization.
+	CompleteFinishedEvents(ctx context.Context, now time.Time) (int, error)
 }
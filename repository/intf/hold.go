@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+// SeatHoldRepository keeps tickets aside while customers check out. Holds
+// take their slots from the same event and tier inventory as bookings, and
+// methods only see holds of events in the organization orgID.
+type SeatHoldRepository interface {
+	// CreateSeatHold reserves the hold's slots atomically, failing like
+	// CreateBooking when they are not available. A user holds seats for an
+	// event at most once: an expired hold is released first, and a live one
+	// makes this fail with AlreadyExists.
+	CreateSeatHold(ctx context.Context, orgID string, hold model.SeatHold) error
+	GetSeatHold(ctx context.Context, orgID, holdID string) (model.SeatHold, error)
+	// ExtendSeatHold moves the expiry of a hold that is still live at now to
+	// expiresAt, failing with FailedPrecondition once it has expired.
+	ExtendSeatHold(ctx context.Context, orgID, holdID string, expiresAt, now time.Time) (model.SeatHold, error)
+	// ReleaseSeatHold deletes the hold and gives its slots back.
+	ReleaseSeatHold(ctx context.Context, orgID, holdID string) error
+	// ReleaseExpiredHolds is housekeeping and runs across every organization:
+	// it releases up to limit holds that expired before now and returns how
+	// many it released.
+	ReleaseExpiredHolds(ctx context.Context, now time.Time, limit int) (int, error)
+}
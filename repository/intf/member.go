@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+// EventMemberRepository methods only see events in the organization orgID.
+type EventMemberRepository interface {
+	// AddEventMember records a pending invitation. It fails with
+	// AlreadyExists if the user is already invited or a member.
+	AddEventMember(ctx context.Context, orgID string, member model.EventMember) error
+	GetEventMember(ctx context.Context, orgID, eventID, userID string) (model.EventMember, error)
+	// AcceptEventMember fails with NotFound unless an invitation is pending.
+	AcceptEventMember(ctx context.Context, orgID, eventID, userID string, at time.Time) error
+	RemoveEventMember(ctx context.Context, orgID, eventID, userID string) error
+	ListEventMembers(ctx context.Context, orgID, eventID string) ([]model.EventMember, error)
+}
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+)
+
+type MFARepository interface {
+	GetMFAFactor(ctx context.Context, subjectID string) (model.MFAFactor, error)
+	// SaveMFAFactor stores a new unconfirmed factor, replacing any earlier
+	// unconfirmed one. It fails with AlreadyExists if a confirmed factor exists.
+	SaveMFAFactor(ctx context.Context, factor model.MFAFactor) error
+	// ConfirmMFAFactor activates the factor, records step as used and
+	// replaces the recovery codes with codeHashes.
+	ConfirmMFAFactor(ctx context.Context, subjectID string, step int64, codeHashes []string) error
+	DeleteMFAFactor(ctx context.Context, subjectID string) error
+	// UseMFAStep records step as used. It fails with AlreadyExists if step is
+	// not newer than the last one used.
+	UseMFAStep(ctx context.Context, subjectID string, step int64) error
+	// UseRecoveryCode marks an unused recovery code as used. It fails with
+	// NotFound if there is none matching.
+	UseRecoveryCode(ctx context.Context, subjectID, codeHash string) error
+}
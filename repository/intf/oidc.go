@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+)
+
+type OIDCRepository interface {
+	// CreateOIDCLoginState also clears out expired attempts
+	CreateOIDCLoginState(ctx context.Context, state model.OIDCLoginState) error
+	// ConsumeOIDCLoginState deletes and returns the attempt, so a state can
+	// only be used once
+	ConsumeOIDCLoginState(ctx context.Context, stateHash string) (model.OIDCLoginState, error)
+	GetUserIdentity(ctx context.Context, provider, subject string) (model.UserIdentity, error)
+	// CreateUserIdentity fails with AlreadyExists if the external account is
+	// already linked
+	CreateUserIdentity(ctx context.Context, identity model.UserIdentity) error
+}
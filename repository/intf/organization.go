@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+)
+
+type OrganizationRepository interface {
+	// CreateOrganization fails with AlreadyExists if the slug is taken.
+	CreateOrganization(ctx context.Context, org model.Organization) error
+	GetOrganization(ctx context.Context, orgID string) (model.Organization, error)
+	GetOrganizationBySlug(ctx context.Context, slug string) (model.Organization, error)
+	// ListOrganizations returns the organizations the user belongs to, or
+	// every organization when userID is empty, by name.
+	ListOrganizations(ctx context.Context, userID string) ([]model.Organization, error)
+	UpdateOrganization(ctx context.Context, orgID string, update model.OrganizationUpdate) (model.Organization, error)
+
+	// AddOrgMember fails with AlreadyExists if the user already belongs to
+	// the organization.
+	AddOrgMember(ctx context.Context, member model.OrgMember) error
+	GetOrgMember(ctx context.Context, orgID, userID string) (model.OrgMember, error)
+	RemoveOrgMember(ctx context.Context, orgID, userID string) error
+	ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+// PaymentRepository tracks the charges of paid bookings.
+type PaymentRepository interface {
+	CreatePayment(ctx context.Context, payment model.Payment) error
+	GetPaymentByBooking(ctx context.Context, bookingID string) (model.Payment, error)
+	// ApplyPaymentEvent records a provider webhook and applies it to the
+	// payment of intentID. A success confirms the booking if it is still
+	// pending_payment, and leaves the payment refund_pending if the booking
+	// was called off meanwhile; a failure only marks the payment failed, so
+	// the customer may try again until the hold expires. It returns the booking
+	// afterwards, and false without changing anything if the event was seen
+	// before. Unknown intents are NotFound.
+	ApplyPaymentEvent(ctx context.Context, provider, eventID, intentID, paymentStatus string) (model.Booking, bool, error)
+	// ListOpenPayments returns the pending and failed payments of bookings
+	// still awaiting payment, of the event or of the user, whichever is set.
+	ListOpenPayments(ctx context.Context, eventID, userID string) ([]model.Payment, error)
+	// ListExpiredPayments is housekeeping and runs across every organization:
+	// the payments of bookings still pending_payment after their hold ran out
+	// before now, oldest first, leaving out those deferred past now.
+	ListExpiredPayments(ctx context.Context, now time.Time, limit int) ([]model.Payment, error)
+	// ListPendingRefunds is housekeeping and runs across every organization:
+	// the refund_pending payments, oldest first, leaving out those deferred
+	// past now.
+	ListPendingRefunds(ctx context.Context, now time.Time, limit int) ([]model.Payment, error)
+	// MarkPaymentRefunded records that the processor returned a
+	// refund_pending payment.
+	MarkPaymentRefunded(ctx context.Context, paymentID string) error
+	// DeferPayment keeps ListExpiredPayments and ListPendingRefunds from
+	// returning the payment again before retryAt.
+	DeferPayment(ctx context.Context, paymentID string, retryAt time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+)
+
+type SessionRepository interface {
+	CreateRefreshToken(ctx context.Context, token model.RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (model.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, oldTokenID string, next model.RefreshToken) error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	// RevokeSubjectRefreshTokens ends every session of the user or admin
+	RevokeSubjectRefreshTokens(ctx context.Context, subjectID string) error
+}
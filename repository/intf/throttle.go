@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+)
+
+type LoginThrottleRepository interface {
+	GetLoginThrottle(ctx context.Context, key string) (model.LoginThrottle, error)
+	// RecordLoginFailure adds a failure and returns the new count. The count
+	// starts over when the previous failure is older than resetAfter.
+	RecordLoginFailure(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error)
+	LockLogin(ctx context.Context, key string, until time.Time) error
+	ClearLoginThrottle(ctx context.Context, key string) error
+	// ListLoginThrottles returns throttles, most recent failure first, and
+	// their total. With lockedOnly only keys locked at now are included.
+	ListLoginThrottles(ctx context.Context, lockedOnly bool, now time.Time, limit, offset int) ([]model.LoginThrottle, int, error)
+}
@@ -3,10 +3,31 @@ package repository
 import (
 	"context"
 	"eventpass/model"
+	"time"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, userID, email, password, firstName, lastName, username, phone string) error
 	GetUser(ctx context.Context, userID string) (model.User, error)
+	GetUserByUsername(ctx context.Context, username string) (model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (model.User, error)
+	UpdatePassword(ctx context.Context, userID, password string) error
+	MarkEmailVerified(ctx context.Context, userID string) error
+	UpdateProfile(ctx context.Context, userID string, update model.ProfileUpdate) (model.User, error)
+	// ChangeEmail sets a new, unverified address and voids verification
+	// links sent to the old one.
+	ChangeEmail(ctx context.Context, userID, email string) error
+	// DeleteUser removes the user with their bookings, sessions and second
+	// factor. Slots held by confirmed bookings are released. It fails with
+	// FailedPrecondition while the user still organizes events or has
+	// bookings that were paid for, whose payments must be kept.
+	DeleteUser(ctx context.Context, userID string) error
+	// ListUsers returns matching users, newest first, and their total.
+	ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error)
+	SuspendUser(ctx context.Context, userID, reason string, at time.Time) error
+	ReactivateUser(ctx context.Context, userID string) error
+	SetUserRole(ctx context.Context, userID, role string) error
+	CreateAdmin(ctx context.Context, adminID, email, password, firstName, lastName, username, phone string) error
 	GetAdminById(ctx context.Context, adminID string) (model.Admin, error)
-}
\ No newline at end of file
+	GetAdminByUsername(ctx context.Context, username string) (model.Admin, error)
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+)
+
+type UserTokenRepository interface {
+	CreateUserToken(ctx context.Context, token model.UserToken) error
+	// ConsumeUserToken marks an unused, unexpired token of the given purpose
+	// as used and returns it, along with invalidating the user's other
+	// outstanding tokens for that purpose. It fails with NotFound otherwise.
+	ConsumeUserToken(ctx context.Context, purpose, tokenHash string) (model.UserToken, error)
+}
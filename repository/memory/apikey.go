@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"slices"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.apiKeys[key.KeyID]; ok {
+		return status.Errorf(codes.AlreadyExists, "API key already exists")
+	}
+	for _, k := range s.apiKeys {
+		if k.KeyHash == key.KeyHash {
+			return alreadyExists("api_keys", "key_hash")
+		}
+	}
+	if key.CreatedAt.IsZero() {
+		key.CreatedAt = time.Now()
+	}
+	key.Scopes = slices.Clone(key.Scopes)
+	s.apiKeys[key.KeyID] = key
+	return nil
+}
+
+func (s *Store) GetAPIKey(ctx context.Context, keyID string) (model.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.apiKeys[keyID]
+	if !ok {
+		return model.APIKey{}, status.Errorf(codes.NotFound, "API key not found")
+	}
+	key.Scopes = slices.Clone(key.Scopes)
+	return key, nil
+}
+
+func (s *Store) GetAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, k := range s.apiKeys {
+		if k.KeyHash == keyHash {
+			k.Scopes = slices.Clone(k.Scopes)
+			return k, nil
+		}
+	}
+	return model.APIKey{}, status.Errorf(codes.NotFound, "API key not found")
+}
+
+func (s *Store) ListAPIKeys(ctx context.Context, ownerID string, includeRevoked bool) ([]model.APIKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var keys []model.APIKey
+	for _, k := range s.apiKeys {
+		if ownerID != "" && k.OwnerID != ownerID {
+			continue
+		}
+		if !includeRevoked && k.RevokedAt != nil {
+			continue
+		}
+		k.Scopes = slices.Clone(k.Scopes)
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.After(keys[j].CreatedAt)
+		}
+		return keys[i].KeyID < keys[j].KeyID
+	})
+	return keys, nil
+}
+
+func (s *Store) RevokeAPIKey(ctx context.Context, keyID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[keyID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "API key not found")
+	}
+	if key.RevokedAt != nil {
+		return status.Errorf(codes.FailedPrecondition, "API key is already revoked")
+	}
+	key.RevokedAt = &at
+	s.apiKeys[keyID] = key
+	return nil
+}
+
+func (s *Store) TouchAPIKey(ctx context.Context, keyID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[keyID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "API key not found")
+	}
+	key.LastUsedAt = &at
+	s.apiKeys[keyID] = key
+	return nil
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateBooking(ctx context.Context, orgID, bookingID, eventID, userID, tierID string, quantity int, expiresAt *time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.reserveSlots(orgID, eventID, tierID, quantity); err != nil {
+		return err
+	}
+	s.insertBooking(bookingID, eventID, userID, tierID, quantity, expiresAt)
+	return nil
+}
+
+func (s *Store) BookSeatHold(ctx context.Context, orgID, bookingID, holdID string, now time.Time, expiresAt *time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hold, err := s.orgHold(orgID, holdID)
+	if err != nil {
+		return err
+	}
+	if !hold.ExpiresAt.After(now) {
+		return status.Errorf(codes.FailedPrecondition, "seat hold has expired")
+	}
+	if event := s.events[hold.EventID]; event.Status != model.EventPublished {
+		return status.Errorf(codes.FailedPrecondition, "event is %s", event.Status)
+	}
+
+	delete(s.holds, holdID)
+	s.insertBooking(bookingID, hold.EventID, hold.UserID, hold.TierID, hold.Quantity, expiresAt)
+	return nil
+}
+
+// reserveSlots takes quantity slots of a published event and, unless tierID
+// is empty, of that tier. Callers must hold s.mu.
+func (s *Store) reserveSlots(orgID, eventID, tierID string, quantity int) error {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return err
+	}
+	left := event.TotalSlots - event.BookedSlots
+	switch {
+	case event.Status == model.EventDraft:
+		return status.Errorf(codes.NotFound, "event not found")
+	case event.Status != model.EventPublished:
+		return status.Errorf(codes.FailedPrecondition, "event is %s", event.Status)
+	case left <= 0:
+		return status.Errorf(codes.FailedPrecondition, "event is fully booked")
+	case quantity > left:
+		return status.Errorf(codes.FailedPrecondition, "not enough slots left for this event (%d available)", left)
+	}
+
+	if tierID != "" {
+		tier, ok := s.tiers[tierID]
+		if !ok || tier.EventID != eventID {
+			return status.Errorf(codes.NotFound, "ticket tier not found")
+		}
+		switch tierLeft := tier.Capacity - tier.Sold; {
+		case tierLeft <= 0:
+			return status.Errorf(codes.FailedPrecondition, "%s tickets are sold out", tier.Name)
+		case quantity > tierLeft:
+			return status.Errorf(codes.FailedPrecondition, "not enough %s tickets left (%d available)", tier.Name, tierLeft)
+		}
+		tier.Sold += quantity
+		s.tiers[tierID] = tier
+	}
+	event.BookedSlots += quantity
+	s.events[eventID] = event
+	return nil
+}
+
+// insertBooking records a booking of slots already reserved, copying the
+// tier's name and price onto it. Callers must hold s.mu.
+func (s *Store) insertBooking(bookingID, eventID, userID, tierID string, quantity int, expiresAt *time.Time) {
+	booking := model.Booking{
+		BookingID: bookingID,
+		EventID:   eventID,
+		UserID:    userID,
+		Status:    model.BookingConfirmed,
+		CreatedAt: time.Now(),
+		Quantity:  quantity,
+		ExpiresAt: expiresAt,
+	}
+	if expiresAt != nil {
+		booking.Status = model.BookingPendingPayment
+	}
+	if tier, ok := s.tiers[tierID]; ok {
+		booking.TierID, booking.TierName = tier.TierID, tier.Name
+		booking.UnitPrice, booking.Currency = tier.PriceMinor, tier.Currency
+	}
+	s.bookings[bookingID] = booking
+}
+
+func (s *Store) GetBooking(ctx context.Context, orgID, bookingID string) (model.Booking, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	booking, err := s.orgBooking(orgID, bookingID)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	return s.withEvent(booking), nil
+}
+
+func (s *Store) ListBookingsByUser(ctx context.Context, orgID, userID, bookingStatus string, limit, offset int) ([]model.Booking, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var bookings []model.Booking
+	for _, b := range s.bookings {
+		if s.events[b.EventID].OrgID != orgID {
+			continue
+		}
+		if b.UserID == userID && (bookingStatus == "" || b.Status == bookingStatus) {
+			bookings = append(bookings, s.withEvent(b))
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool {
+		if !bookings[i].CreatedAt.Equal(bookings[j].CreatedAt) {
+			return bookings[i].CreatedAt.After(bookings[j].CreatedAt)
+		}
+		return bookings[i].BookingID < bookings[j].BookingID
+	})
+
+	total := len(bookings)
+	start := min(offset, total)
+	end := min(start+limit, total)
+	return bookings[start:end], total, nil
+}
+
+func (s *Store) ListAttendees(ctx context.Context, orgID, eventID, bookingStatus string, limit, offset int) ([]model.Attendee, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, 0, nil
+	}
+	var attendees []model.Attendee
+	for _, b := range s.bookings {
+		if b.EventID != eventID || (bookingStatus != "" && b.Status != bookingStatus) {
+			continue
+		}
+		user := s.users[b.UserID]
+		attendees = append(attendees, model.Attendee{
+			BookingID:   b.BookingID,
+			UserID:      b.UserID,
+			Username:    user.Username,
+			FirstName:   user.FirstName,
+			LastName:    user.LastName,
+			Email:       user.Email,
+			Status:      b.Status,
+			BookedAt:    b.CreatedAt,
+			TierName:    b.TierName,
+			Quantity:    b.Quantity,
+			CheckedInAt: b.CheckedInAt,
+		})
+	}
+	sort.Slice(attendees, func(i, j int) bool {
+		if !attendees[i].BookedAt.Equal(attendees[j].BookedAt) {
+			return attendees[i].BookedAt.Before(attendees[j].BookedAt)
+		}
+		return attendees[i].BookingID < attendees[j].BookingID
+	})
+
+	total := len(attendees)
+	start := min(offset, total)
+	end := min(start+limit, total)
+	return attendees[start:end], total, nil
+}
+
+func (s *Store) CancelBooking(ctx context.Context, orgID, bookingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, err := s.orgBooking(orgID, bookingID)
+	if err != nil || !model.HoldsSlots(booking.Status) {
+		return status.Errorf(codes.FailedPrecondition, "booking is not active")
+	}
+	for _, p := range s.payments {
+		if p.BookingID == bookingID && model.PaidFor(p.Status) {
+			return status.Errorf(codes.FailedPrecondition, "paid bookings cannot be cancelled; contact the organizer")
+		}
+	}
+	now := time.Now()
+	booking.Status = model.BookingCancelled
+	booking.CancelledAt = &now
+	booking.ExpiresAt = nil
+	s.bookings[bookingID] = booking
+
+	s.releaseSlots(booking)
+	return nil
+}
+
+func (s *Store) ExpireBooking(ctx context.Context, bookingID string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, ok := s.bookings[bookingID]
+	if !ok || booking.Status != model.BookingPendingPayment || booking.ExpiresAt == nil || !booking.ExpiresAt.Before(now) {
+		return status.Errorf(codes.FailedPrecondition, "booking is not awaiting payment")
+	}
+	booking.Status = model.BookingExpired
+	booking.CancelledAt = &now
+	booking.ExpiresAt = nil
+	s.bookings[bookingID] = booking
+
+	s.releaseSlots(booking)
+	return nil
+}
+
+func (s *Store) ListAbandonedBookings(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	started := make(map[string]bool, len(s.payments))
+	for _, p := range s.payments {
+		started[p.BookingID] = true
+	}
+	var bookings []model.Booking
+	for _, b := range s.bookings {
+		if b.Status == model.BookingPendingPayment && b.ExpiresAt != nil && b.ExpiresAt.Before(now) && !started[b.BookingID] {
+			bookings = append(bookings, b)
+		}
+	}
+	sort.Slice(bookings, func(i, j int) bool {
+		return bookings[i].ExpiresAt.Before(*bookings[j].ExpiresAt)
+	})
+
+	var ids []string
+	for _, b := range bookings[:min(limit, len(bookings))] {
+		ids = append(ids, b.BookingID)
+	}
+	return ids, nil
+}
+
+func (s *Store) CheckInBooking(ctx context.Context, orgID, bookingID string, at time.Time) (model.Booking, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	booking, err := s.orgBooking(orgID, bookingID)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	if booking.Status != model.BookingConfirmed {
+		return model.Booking{}, status.Errorf(codes.FailedPrecondition, "booking is not active")
+	}
+	if booking.CheckedInAt != nil {
+		return model.Booking{}, status.Errorf(codes.FailedPrecondition, "booking was already checked in at %s", booking.CheckedInAt.Format(time.RFC3339))
+	}
+	booking.CheckedInAt = &at
+	s.bookings[bookingID] = booking
+	return s.withEvent(booking), nil
+}
+
+// orgBooking returns the booking if its event belongs to the organization.
+// Callers must hold s.mu.
+func (s *Store) orgBooking(orgID, bookingID string) (model.Booking, error) {
+	booking, ok := s.bookings[bookingID]
+	if !ok || s.events[booking.EventID].OrgID != orgID {
+		return model.Booking{}, status.Errorf(codes.NotFound, "booking not found")
+	}
+	return booking, nil
+}
+
+// releaseSlots gives the booking's slots back to its event and tier and
+// cancels its payment if it was never taken. Callers must hold s.mu.
+func (s *Store) releaseSlots(booking model.Booking) {
+	s.cancelPayment(booking.BookingID)
+	s.returnSlots(booking.EventID, booking.TierID, booking.Quantity)
+}
+
+// returnSlots puts quantity slots back into the event's and tier's
+// inventory. Callers must hold s.mu.
+func (s *Store) returnSlots(eventID, tierID string, quantity int) {
+	if event, ok := s.events[eventID]; ok {
+		event.BookedSlots = max(event.BookedSlots-quantity, 0)
+		s.events[eventID] = event
+	}
+	if tier, ok := s.tiers[tierID]; ok {
+		tier.Sold = max(tier.Sold-quantity, 0)
+		s.tiers[tierID] = tier
+	}
+}
+
+// withEvent fills in the event details the Postgres repository joins in.
+// Callers must hold s.mu.
+func (s *Store) withEvent(booking model.Booking) model.Booking {
+	booking.Event = s.events[booking.EventID]
+	return booking
+}
@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"eventpass/validate"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateEvent(ctx context.Context, orgID, eventID, eventTitle, eventDescription, eventLocation, eventDate, eventStartTime, eventEndTime, CreatedBy string, totalSlots int32, tiers []model.TicketTier) error {
+	date, err := time.Parse("2006-01-02", eventDate)
+	if err != nil {
+		return fmt.Errorf("invalid event date: %w", err)
+	}
+	start, err := atTime(date, eventStartTime)
+	if err != nil {
+		return err
+	}
+	end, err := atTime(date, eventEndTime)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.events[eventID]; ok {
+		return status.Errorf(codes.AlreadyExists, "event already exists")
+	}
+	if _, ok := s.orgs[orgID]; !ok {
+		return status.Errorf(codes.FailedPrecondition, "organization does not exist")
+	}
+	// Mirrors the creator foreign keys of the events table
+	_, isUser := s.users[CreatedBy]
+	_, isAdmin := s.admins[CreatedBy]
+	if !isUser && !isAdmin {
+		return status.Errorf(codes.FailedPrecondition, "creator does not exist")
+	}
+	s.events[eventID] = model.Event{
+		Event_ID:          eventID,
+		Event_Title:       eventTitle,
+		Event_Description: eventDescription,
+		Event_Date:        date,
+		Event_Start_Time:  start,
+		Event_End_Time:    end,
+		Event_Location:    eventLocation,
+		TotalSlots:        int(totalSlots),
+		CreatedBy:         CreatedBy,
+		Version:           1,
+		Status:            model.EventDraft,
+		CreatedAt:         time.Now(),
+		OrgID:             orgID,
+	}
+	for _, tier := range tiers {
+		tier.EventID = eventID
+		tier.Sold = 0
+		tier.CreatedAt = time.Now()
+		s.tiers[tier.TierID] = tier
+	}
+	return nil
+}
+
+func (s *Store) GetEvent(ctx context.Context, orgID, eventID string) (model.Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.orgEvent(orgID, eventID)
+}
+
+func (s *Store) ListTicketTiers(ctx context.Context, orgID string, eventIDs []string) ([]model.TicketTier, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var tiers []model.TicketTier
+	for _, t := range s.tiers {
+		if slices.Contains(eventIDs, t.EventID) && s.events[t.EventID].OrgID == orgID {
+			tiers = append(tiers, t)
+		}
+	}
+	sort.Slice(tiers, func(i, j int) bool {
+		if tiers[i].EventID != tiers[j].EventID {
+			return tiers[i].EventID < tiers[j].EventID
+		}
+		return tiers[i].Position < tiers[j].Position
+	})
+	return tiers, nil
+}
+
+func (s *Store) ListEvents(ctx context.Context, filter model.EventFilter) ([]model.Event, int, error) {
+	s.mu.RLock()
+	var matched []model.Event
+	for _, e := range s.events {
+		if matchesEventFilter(e, filter) && (filter.MemberID == "" || s.isEventMember(e, filter.MemberID)) {
+			matched = append(matched, e)
+		}
+	}
+	s.mu.RUnlock()
+
+	sortBy := filter.SortBy
+	less := func(a, b model.Event) bool {
+		ca, cb := a.Cursor(sortBy), b.Cursor(sortBy)
+		if ca.SortValue != cb.SortValue {
+			return ca.SortValue < cb.SortValue
+		}
+		return ca.EventID < cb.EventID
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if filter.Descending {
+			return less(matched[j], matched[i])
+		}
+		return less(matched[i], matched[j])
+	})
+	total := len(matched)
+
+	start := 0
+	if filter.Cursor != nil {
+		start = len(matched)
+		for i, e := range matched {
+			c := e.Cursor(sortBy)
+			after := c.SortValue > filter.Cursor.SortValue ||
+				(c.SortValue == filter.Cursor.SortValue && c.EventID > filter.Cursor.EventID)
+			if filter.Descending {
+				after = c.SortValue < filter.Cursor.SortValue ||
+					(c.SortValue == filter.Cursor.SortValue && c.EventID < filter.Cursor.EventID)
+			}
+			if after {
+				start = i
+				break
+			}
+		}
+	} else if filter.Offset > 0 {
+		start = min(filter.Offset, len(matched))
+	}
+	end := min(start+filter.Limit, len(matched))
+	return matched[start:end], total, nil
+}
+
+func (s *Store) UpdateEvent(ctx context.Context, orgID, eventID string, version int, update model.EventUpdate) (model.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return model.Event{}, err
+	}
+	if event.Version != version {
+		return model.Event{}, status.Errorf(codes.Aborted, "event was modified (now at version %d)", event.Version)
+	}
+	if event.Status == model.EventCancelled || event.Status == model.EventCompleted {
+		return model.Event{}, status.Errorf(codes.FailedPrecondition, "%s events cannot be changed", event.Status)
+	}
+	if update.TotalSlots != nil && *update.TotalSlots < event.BookedSlots {
+		return model.Event{}, status.Errorf(codes.FailedPrecondition, "total_slots cannot be less than the %d slots already booked", event.BookedSlots)
+	}
+
+	if update.Title != nil {
+		event.Event_Title = *update.Title
+	}
+	if update.Description != nil {
+		event.Event_Description = *update.Description
+	}
+	if update.Location != nil {
+		event.Event_Location = *update.Location
+	}
+	if update.TotalSlots != nil {
+		event.TotalSlots = *update.TotalSlots
+	}
+
+	// Times are stored relative to the event date, so re-derive them and
+	// check them together, as the request may carry only some of them
+	date, start, end, err := update.Schedule(event)
+	if err != nil {
+		return model.Event{}, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if update.ChangesSchedule() {
+		var v validate.Violations
+		validate.Schedule(&v, "event.", start, end, update.Date != nil || update.StartTime != nil)
+		if err := v.Err(); err != nil {
+			return model.Event{}, err
+		}
+	}
+	event.Event_Date, event.Event_Start_Time, event.Event_End_Time = date, start, end
+
+	event.Version++
+	s.events[eventID] = event
+	return event, nil
+}
+
+func (s *Store) DeleteEvent(ctx context.Context, orgID, eventID string, force bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return err
+	}
+	if event.BookedSlots > 0 && !force {
+		return status.Errorf(codes.FailedPrecondition, "event has %d active bookings", event.BookedSlots)
+	}
+	for _, p := range s.payments {
+		if s.bookings[p.BookingID].EventID == eventID && model.PaidFor(p.Status) {
+			return status.Errorf(codes.FailedPrecondition, "events with paid bookings cannot be deleted")
+		}
+	}
+
+	for id, b := range s.bookings {
+		if b.EventID == eventID {
+			s.deletePayment(id)
+			delete(s.bookings, id)
+		}
+	}
+	for key, m := range s.eventMembers {
+		if m.EventID == eventID {
+			delete(s.eventMembers, key)
+		}
+	}
+	for id, t := range s.tiers {
+		if t.EventID == eventID {
+			delete(s.tiers, id)
+		}
+	}
+	for id, h := range s.holds {
+		if h.EventID == eventID {
+			delete(s.holds, id)
+		}
+	}
+	delete(s.events, eventID)
+	return nil
+}
+
+func (s *Store) PublishEvent(ctx context.Context, orgID, eventID string) (model.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, err := s.eventForTransition(orgID, eventID, model.EventPublished)
+	if err != nil {
+		return model.Event{}, err
+	}
+	event.Status = model.EventPublished
+	event.Version++
+	s.events[eventID] = event
+	return event, nil
+}
+
+func (s *Store) CancelEvent(ctx context.Context, orgID, eventID, reason string) (model.Event, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, err := s.eventForTransition(orgID, eventID, model.EventCancelled)
+	if err != nil {
+		return model.Event{}, 0, err
+	}
+
+	cancelled := 0
+	now := time.Now()
+	for id, b := range s.bookings {
+		if b.EventID == eventID && model.HoldsSlots(b.Status) {
+			b.Status = model.BookingCancelled
+			b.CancelledAt = &now
+			b.CancelReason = reason
+			b.ExpiresAt = nil
+			s.bookings[id] = b
+			s.cancelPayment(id)
+			s.refundPayment(id)
+			cancelled++
+		}
+	}
+
+	for id, h := range s.holds {
+		if h.EventID == eventID {
+			delete(s.holds, id)
+		}
+	}
+	for id, t := range s.tiers {
+		if t.EventID == eventID {
+			t.Sold = 0
+			s.tiers[id] = t
+		}
+	}
+
+	event.Status = model.EventCancelled
+	event.CancelReason = reason
+	event.BookedSlots = 0
+	event.Version++
+	s.events[eventID] = event
+	return event, cancelled, nil
+}
+
+func (s *Store) CompleteFinishedEvents(ctx context.Context, now time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Event times are stored as wall clock values in UTC
+	wallNow := time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), time.UTC)
+	completed := 0
+	for id, e := range s.events {
+		if e.Status == model.EventPublished && e.Event_End_Time.Before(wallNow) {
+			e.Status = model.EventCompleted
+			e.Version++
+			s.events[id] = e
+			completed++
+		}
+	}
+	return completed, nil
+}
+
+// orgEvent returns the event if it belongs to the organization. Callers must
+// hold s.mu.
+func (s *Store) orgEvent(orgID, eventID string) (model.Event, error) {
+	event, ok := s.events[eventID]
+	if !ok || event.OrgID != orgID {
+		return model.Event{}, status.Errorf(codes.NotFound, "event not found")
+	}
+	return event, nil
+}
+
+// eventForTransition returns the event if it may move to the target state.
+// Callers must hold s.mu.
+func (s *Store) eventForTransition(orgID, eventID, to string) (model.Event, error) {
+	event, err := s.orgEvent(orgID, eventID)
+	if err != nil {
+		return model.Event{}, err
+	}
+	if !model.CanTransition(event.Status, to) {
+		return model.Event{}, status.Errorf(codes.FailedPrecondition, "cannot move event from %s to %s", event.Status, to)
+	}
+	return event, nil
+}
+
+func matchesEventFilter(e model.Event, filter model.EventFilter) bool {
+	if e.OrgID != filter.OrgID {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, e.Status) {
+		return false
+	}
+	date := e.Event_Date.Format("2006-01-02")
+	if filter.StartDate != "" && date < filter.StartDate {
+		return false
+	}
+	if filter.EndDate != "" && date > filter.EndDate {
+		return false
+	}
+	if filter.Location != "" && !strings.Contains(strings.ToLower(e.Event_Location), strings.ToLower(filter.Location)) {
+		return false
+	}
+	if filter.CreatedBy != "" && e.CreatedBy != filter.CreatedBy {
+		return false
+	}
+	if filter.HasFreeSlots && e.BookedSlots >= e.TotalSlots {
+		return false
+	}
+	return true
+}
+
+// atTime combines a date with a "15:04" or "15:04:05" time of day, matching
+// what Postgres accepts for TIME columns.
+func atTime(date time.Time, clock string) (time.Time, error) {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if t, err := time.Parse(layout, clock); err == nil {
+			return date.Add(time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q", clock)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateSeatHold(ctx context.Context, orgID string, hold model.SeatHold) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, h := range s.holds {
+		if h.EventID != hold.EventID || h.UserID != hold.UserID {
+			continue
+		}
+		if h.ExpiresAt.After(hold.CreatedAt) {
+			return status.Errorf(codes.AlreadyExists, "you already hold seats for this event; extend or release that hold")
+		}
+		s.releaseHold(id)
+	}
+
+	if err := s.reserveSlots(orgID, hold.EventID, hold.TierID, hold.Quantity); err != nil {
+		return err
+	}
+	s.holds[hold.HoldID] = hold
+	return nil
+}
+
+func (s *Store) GetSeatHold(ctx context.Context, orgID, holdID string) (model.SeatHold, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.orgHold(orgID, holdID)
+}
+
+func (s *Store) ExtendSeatHold(ctx context.Context, orgID, holdID string, expiresAt, now time.Time) (model.SeatHold, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hold, err := s.orgHold(orgID, holdID)
+	if err != nil {
+		return model.SeatHold{}, err
+	}
+	if !hold.ExpiresAt.After(now) {
+		return model.SeatHold{}, status.Errorf(codes.FailedPrecondition, "seat hold has expired")
+	}
+	hold.ExpiresAt = expiresAt
+	s.holds[holdID] = hold
+	return hold, nil
+}
+
+func (s *Store) ReleaseSeatHold(ctx context.Context, orgID, holdID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.orgHold(orgID, holdID); err != nil {
+		return err
+	}
+	s.releaseHold(holdID)
+	return nil
+}
+
+func (s *Store) ReleaseExpiredHolds(ctx context.Context, now time.Time, limit int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expired []model.SeatHold
+	for _, h := range s.holds {
+		if !h.ExpiresAt.After(now) {
+			expired = append(expired, h)
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(expired[j].ExpiresAt)
+	})
+	expired = expired[:min(limit, len(expired))]
+	for _, h := range expired {
+		s.releaseHold(h.HoldID)
+	}
+	return len(expired), nil
+}
+
+// orgHold returns the hold if its event belongs to the organization. Callers
+// must hold s.mu.
+func (s *Store) orgHold(orgID, holdID string) (model.SeatHold, error) {
+	hold, ok := s.holds[holdID]
+	if !ok || s.events[hold.EventID].OrgID != orgID {
+		return model.SeatHold{}, status.Errorf(codes.NotFound, "seat hold not found")
+	}
+	return hold, nil
+}
+
+// releaseHold deletes a hold and returns its slots. Callers must hold s.mu.
+func (s *Store) releaseHold(holdID string) {
+	hold, ok := s.holds[holdID]
+	if !ok {
+		return
+	}
+	delete(s.holds, holdID)
+	s.returnSlots(hold.EventID, hold.TierID, hold.Quantity)
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func memberKey(eventID, userID string) string {
+	return eventID + "/" + userID
+}
+
+func (s *Store) AddEventMember(ctx context.Context, orgID string, member model.EventMember) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.orgEvent(orgID, member.EventID); err != nil {
+		return err
+	}
+	if _, ok := s.users[member.UserID]; !ok {
+		return status.Errorf(codes.FailedPrecondition, "user does not exist")
+	}
+	key := memberKey(member.EventID, member.UserID)
+	if _, ok := s.eventMembers[key]; ok {
+		return status.Errorf(codes.AlreadyExists, "user is already a member or invited")
+	}
+	if member.InvitedAt.IsZero() {
+		member.InvitedAt = time.Now()
+	}
+	s.eventMembers[key] = member
+	return nil
+}
+
+func (s *Store) GetEventMember(ctx context.Context, orgID, eventID, userID string) (model.EventMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return model.EventMember{}, status.Errorf(codes.NotFound, "member not found")
+	}
+	member, ok := s.eventMembers[memberKey(eventID, userID)]
+	if !ok {
+		return model.EventMember{}, status.Errorf(codes.NotFound, "member not found")
+	}
+	return s.withUser(member), nil
+}
+
+func (s *Store) AcceptEventMember(ctx context.Context, orgID, eventID, userID string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return status.Errorf(codes.NotFound, "no pending invitation")
+	}
+	key := memberKey(eventID, userID)
+	member, ok := s.eventMembers[key]
+	if !ok || member.AcceptedAt != nil {
+		return status.Errorf(codes.NotFound, "no pending invitation")
+	}
+	member.AcceptedAt = &at
+	s.eventMembers[key] = member
+	return nil
+}
+
+func (s *Store) RemoveEventMember(ctx context.Context, orgID, eventID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return status.Errorf(codes.NotFound, "member not found")
+	}
+	key := memberKey(eventID, userID)
+	if _, ok := s.eventMembers[key]; !ok {
+		return status.Errorf(codes.NotFound, "member not found")
+	}
+	delete(s.eventMembers, key)
+	return nil
+}
+
+func (s *Store) ListEventMembers(ctx context.Context, orgID, eventID string) ([]model.EventMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if _, err := s.orgEvent(orgID, eventID); err != nil {
+		return nil, nil
+	}
+	var members []model.EventMember
+	for _, m := range s.eventMembers {
+		if m.EventID == eventID {
+			members = append(members, s.withUser(m))
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if !members[i].InvitedAt.Equal(members[j].InvitedAt) {
+			return members[i].InvitedAt.Before(members[j].InvitedAt)
+		}
+		return members[i].UserID < members[j].UserID
+	})
+	return members, nil
+}
+
+// isEventMember reports whether the user created the event or accepted a
+// role on it. Callers must hold s.mu.
+func (s *Store) isEventMember(event model.Event, userID string) bool {
+	if event.CreatedBy == userID {
+		return true
+	}
+	member, ok := s.eventMembers[memberKey(event.Event_ID, userID)]
+	return ok && member.AcceptedAt != nil
+}
+
+// withUser fills in the user details the Postgres repository joins in.
+// Callers must hold s.mu.
+func (s *Store) withUser(member model.EventMember) model.EventMember {
+	user := s.users[member.UserID]
+	member.Username = user.Username
+	member.Email = user.Email
+	return member
+}
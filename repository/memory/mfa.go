@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) GetMFAFactor(ctx context.Context, subjectID string) (model.MFAFactor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	factor, ok := s.mfaFactors[subjectID]
+	if !ok {
+		return model.MFAFactor{}, status.Errorf(codes.NotFound, "mfa factor not found")
+	}
+	return factor, nil
+}
+
+func (s *Store) SaveMFAFactor(ctx context.Context, factor model.MFAFactor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.mfaFactors[factor.SubjectID]; ok && existing.ConfirmedAt != nil {
+		return status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+	}
+	s.mfaFactors[factor.SubjectID] = model.MFAFactor{
+		SubjectID: factor.SubjectID,
+		Secret:    factor.Secret,
+		CreatedAt: time.Now(),
+	}
+	return nil
+}
+
+func (s *Store) ConfirmMFAFactor(ctx context.Context, subjectID string, step int64, codeHashes []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	factor, ok := s.mfaFactors[subjectID]
+	if !ok || factor.ConfirmedAt != nil {
+		return status.Errorf(codes.FailedPrecondition, "no pending two-factor enrolment")
+	}
+	now := time.Now()
+	factor.ConfirmedAt = &now
+	factor.LastUsedStep = step
+	s.mfaFactors[subjectID] = factor
+
+	hashes := map[string]bool{}
+	for _, hash := range codeHashes {
+		hashes[hash] = false
+	}
+	s.recoveryCodes[subjectID] = hashes
+	return nil
+}
+
+func (s *Store) DeleteMFAFactor(ctx context.Context, subjectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.mfaFactors[subjectID]; !ok {
+		return status.Errorf(codes.NotFound, "mfa factor not found")
+	}
+	delete(s.mfaFactors, subjectID)
+	delete(s.recoveryCodes, subjectID)
+	return nil
+}
+
+func (s *Store) UseMFAStep(ctx context.Context, subjectID string, step int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	factor, ok := s.mfaFactors[subjectID]
+	if !ok || factor.LastUsedStep >= step {
+		return status.Errorf(codes.AlreadyExists, "code has already been used")
+	}
+	factor.LastUsedStep = step
+	s.mfaFactors[subjectID] = factor
+	return nil
+}
+
+func (s *Store) UseRecoveryCode(ctx context.Context, subjectID, codeHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	used, ok := s.recoveryCodes[subjectID][codeHash]
+	if !ok || used {
+		return status.Errorf(codes.NotFound, "recovery code not found")
+	}
+	s.recoveryCodes[subjectID][codeHash] = true
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateOIDCLoginState(ctx context.Context, state model.OIDCLoginState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for hash, st := range s.oidcStates {
+		if st.ExpiresAt.Before(now) {
+			delete(s.oidcStates, hash)
+		}
+	}
+	s.oidcStates[state.StateHash] = state
+	return nil
+}
+
+func (s *Store) ConsumeOIDCLoginState(ctx context.Context, stateHash string) (model.OIDCLoginState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.oidcStates[stateHash]
+	if !ok {
+		return model.OIDCLoginState{}, status.Errorf(codes.NotFound, "login state not found")
+	}
+	delete(s.oidcStates, stateHash)
+	return state, nil
+}
+
+func (s *Store) GetUserIdentity(ctx context.Context, provider, subject string) (model.UserIdentity, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	identity, ok := s.identities[memberKey(provider, subject)]
+	if !ok {
+		return model.UserIdentity{}, status.Errorf(codes.NotFound, "identity not found")
+	}
+	return identity, nil
+}
+
+func (s *Store) CreateUserIdentity(ctx context.Context, identity model.UserIdentity) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[identity.UserID]; !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	key := memberKey(identity.Provider, identity.Subject)
+	if _, ok := s.identities[key]; ok {
+		return status.Errorf(codes.AlreadyExists, "identity is already linked")
+	}
+	if identity.CreatedAt.IsZero() {
+		identity.CreatedAt = time.Now()
+	}
+	s.identities[key] = identity
+	return nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateOrganization(ctx context.Context, org model.Organization) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.orgs[org.OrgID]; ok {
+		return status.Errorf(codes.AlreadyExists, "organization already exists")
+	}
+	for _, o := range s.orgs {
+		if strings.EqualFold(o.Slug, org.Slug) {
+			return alreadyExists("organizations", "slug")
+		}
+	}
+	if org.CreatedAt.IsZero() {
+		org.CreatedAt = time.Now()
+	}
+	s.orgs[org.OrgID] = org
+	return nil
+}
+
+func (s *Store) GetOrganization(ctx context.Context, orgID string) (model.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	org, ok := s.orgs[orgID]
+	if !ok {
+		return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+	}
+	return org, nil
+}
+
+func (s *Store) GetOrganizationBySlug(ctx context.Context, slug string) (model.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, o := range s.orgs {
+		if strings.EqualFold(o.Slug, slug) {
+			return o, nil
+		}
+	}
+	return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+}
+
+func (s *Store) ListOrganizations(ctx context.Context, userID string) ([]model.Organization, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var orgs []model.Organization
+	for _, o := range s.orgs {
+		if _, ok := s.orgMembers[memberKey(o.OrgID, userID)]; userID == "" || ok {
+			orgs = append(orgs, o)
+		}
+	}
+	sort.Slice(orgs, func(i, j int) bool {
+		if orgs[i].Name != orgs[j].Name {
+			return orgs[i].Name < orgs[j].Name
+		}
+		return orgs[i].OrgID < orgs[j].OrgID
+	})
+	return orgs, nil
+}
+
+func (s *Store) UpdateOrganization(ctx context.Context, orgID string, update model.OrganizationUpdate) (model.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	org, ok := s.orgs[orgID]
+	if !ok {
+		return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+	}
+	if update.Name != nil {
+		org.Name = *update.Name
+	}
+	if update.LogoURL != nil {
+		org.LogoURL = *update.LogoURL
+	}
+	if update.PrimaryColor != nil {
+		org.PrimaryColor = *update.PrimaryColor
+	}
+	if update.SupportEmail != nil {
+		org.SupportEmail = *update.SupportEmail
+	}
+	if update.MembersOnly != nil {
+		org.MembersOnly = *update.MembersOnly
+	}
+	if update.MaxEventSlots != nil {
+		org.MaxEventSlots = *update.MaxEventSlots
+	}
+	s.orgs[orgID] = org
+	return org, nil
+}
+
+func (s *Store) AddOrgMember(ctx context.Context, member model.OrgMember) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.orgs[member.OrgID]; !ok {
+		return status.Errorf(codes.FailedPrecondition, "organization does not exist")
+	}
+	if _, ok := s.users[member.UserID]; !ok {
+		return status.Errorf(codes.FailedPrecondition, "user does not exist")
+	}
+	key := memberKey(member.OrgID, member.UserID)
+	if _, ok := s.orgMembers[key]; ok {
+		return status.Errorf(codes.AlreadyExists, "user is already a member")
+	}
+	if member.JoinedAt.IsZero() {
+		member.JoinedAt = time.Now()
+	}
+	s.orgMembers[key] = member
+	return nil
+}
+
+func (s *Store) GetOrgMember(ctx context.Context, orgID, userID string) (model.OrgMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	member, ok := s.orgMembers[memberKey(orgID, userID)]
+	if !ok {
+		return model.OrgMember{}, status.Errorf(codes.NotFound, "member not found")
+	}
+	return s.withOrgUser(member), nil
+}
+
+func (s *Store) RemoveOrgMember(ctx context.Context, orgID, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memberKey(orgID, userID)
+	if _, ok := s.orgMembers[key]; !ok {
+		return status.Errorf(codes.NotFound, "member not found")
+	}
+	delete(s.orgMembers, key)
+	return nil
+}
+
+func (s *Store) ListOrgMembers(ctx context.Context, orgID string) ([]model.OrgMember, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var members []model.OrgMember
+	for _, m := range s.orgMembers {
+		if m.OrgID == orgID {
+			members = append(members, s.withOrgUser(m))
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if !members[i].JoinedAt.Equal(members[j].JoinedAt) {
+			return members[i].JoinedAt.Before(members[j].JoinedAt)
+		}
+		return members[i].UserID < members[j].UserID
+	})
+	return members, nil
+}
+
+// withOrgUser fills in the user details the Postgres repository joins in.
+// Callers must hold s.mu.
+func (s *Store) withOrgUser(member model.OrgMember) model.OrgMember {
+	user := s.users[member.UserID]
+	member.Username = user.Username
+	member.Email = user.Email
+	return member
+}
@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreatePayment(ctx context.Context, payment model.Payment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bookings[payment.BookingID]; !ok {
+		return status.Errorf(codes.FailedPrecondition, "booking does not exist")
+	}
+	for _, p := range s.payments {
+		if p.BookingID == payment.BookingID {
+			return alreadyExists("payments", "booking_id")
+		}
+		if p.Provider == payment.Provider && p.IntentID == payment.IntentID {
+			return alreadyExists("payments", "intent_id")
+		}
+	}
+	payment.UpdatedAt = payment.CreatedAt
+	s.payments[payment.PaymentID] = payment
+	return nil
+}
+
+func (s *Store) GetPaymentByBooking(ctx context.Context, bookingID string) (model.Payment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, p := range s.payments {
+		if p.BookingID == bookingID {
+			return p, nil
+		}
+	}
+	return model.Payment{}, status.Errorf(codes.NotFound, "payment not found")
+}
+
+func (s *Store) ApplyPaymentEvent(ctx context.Context, provider, eventID, intentID, paymentStatus string) (model.Booking, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.paymentEvents[memberKey(provider, eventID)] {
+		return model.Booking{}, false, nil
+	}
+	var payment model.Payment
+	found := false
+	for _, p := range s.payments {
+		if p.Provider == provider && p.IntentID == intentID {
+			payment, found = p, true
+			break
+		}
+	}
+	if !found {
+		return model.Booking{}, false, status.Errorf(codes.NotFound, "payment not found")
+	}
+	s.paymentEvents[memberKey(provider, eventID)] = true
+
+	booking := s.bookings[payment.BookingID]
+	switch paymentStatus {
+	case model.PaymentSucceeded:
+		// The money was taken even if the booking was called off meanwhile,
+		// in which case it goes back
+		if model.PaidFor(payment.Status) {
+			break
+		}
+		payment.Status = model.PaymentSucceeded
+		payment.UpdatedAt = time.Now()
+		switch booking.Status {
+		case model.BookingPendingPayment:
+			booking.Status = model.BookingConfirmed
+			booking.ExpiresAt = nil
+			s.bookings[booking.BookingID] = booking
+		case model.BookingConfirmed:
+		default:
+			payment.Status = model.PaymentRefundPending
+		}
+	case model.PaymentFailed:
+		if payment.Status == model.PaymentPending {
+			payment.Status = model.PaymentFailed
+			payment.UpdatedAt = time.Now()
+		}
+	}
+	s.payments[payment.PaymentID] = payment
+	return s.withEvent(booking), true, nil
+}
+
+func (s *Store) ListOpenPayments(ctx context.Context, eventID, userID string) ([]model.Payment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var payments []model.Payment
+	for _, p := range s.payments {
+		b := s.bookings[p.BookingID]
+		if p.Status != model.PaymentPending && p.Status != model.PaymentFailed || b.Status != model.BookingPendingPayment {
+			continue
+		}
+		if (eventID == "" || b.EventID == eventID) && (userID == "" || b.UserID == userID) {
+			payments = append(payments, p)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].CreatedAt.Before(payments[j].CreatedAt)
+	})
+	return payments, nil
+}
+
+func (s *Store) ListExpiredPayments(ctx context.Context, now time.Time, limit int) ([]model.Payment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var payments []model.Payment
+	for _, p := range s.payments {
+		b := s.bookings[p.BookingID]
+		if b.Status == model.BookingPendingPayment && b.ExpiresAt != nil && b.ExpiresAt.Before(now) && (p.RetryAt == nil || !p.RetryAt.After(now)) {
+			payments = append(payments, p)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return s.bookings[payments[i].BookingID].ExpiresAt.Before(*s.bookings[payments[j].BookingID].ExpiresAt)
+	})
+	return payments[:min(limit, len(payments))], nil
+}
+
+func (s *Store) ListPendingRefunds(ctx context.Context, now time.Time, limit int) ([]model.Payment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var payments []model.Payment
+	for _, p := range s.payments {
+		if p.Status == model.PaymentRefundPending && (p.RetryAt == nil || !p.RetryAt.After(now)) {
+			payments = append(payments, p)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].UpdatedAt.Before(payments[j].UpdatedAt)
+	})
+	return payments[:min(limit, len(payments))], nil
+}
+
+func (s *Store) MarkPaymentRefunded(ctx context.Context, paymentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payment, ok := s.payments[paymentID]
+	if !ok || payment.Status != model.PaymentRefundPending {
+		return status.Errorf(codes.FailedPrecondition, "payment is not awaiting a refund")
+	}
+	payment.Status = model.PaymentRefunded
+	payment.RetryAt = nil
+	payment.UpdatedAt = time.Now()
+	s.payments[paymentID] = payment
+	return nil
+}
+
+func (s *Store) DeferPayment(ctx context.Context, paymentID string, retryAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	payment, ok := s.payments[paymentID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "payment not found")
+	}
+	payment.RetryAt = &retryAt
+	s.payments[paymentID] = payment
+	return nil
+}
+
+// refundPayment marks the booking's payment for a refund if it went through.
+// Callers must hold s.mu.
+func (s *Store) refundPayment(bookingID string) {
+	for id, p := range s.payments {
+		if p.BookingID == bookingID && p.Status == model.PaymentSucceeded {
+			p.Status = model.PaymentRefundPending
+			p.UpdatedAt = time.Now()
+			s.payments[id] = p
+		}
+	}
+}
+
+// cancelPayment cancels the booking's payment unless it went through.
+// Callers must hold s.mu.
+func (s *Store) cancelPayment(bookingID string) {
+	for id, p := range s.payments {
+		if p.BookingID == bookingID && (p.Status == model.PaymentPending || p.Status == model.PaymentFailed) {
+			p.Status = model.PaymentCancelled
+			p.UpdatedAt = time.Now()
+			s.payments[id] = p
+		}
+	}
+}
+
+// deletePayment mirrors the cascade from bookings to payments. Callers must
+// hold s.mu.
+func (s *Store) deletePayment(bookingID string) {
+	for id, p := range s.payments {
+		if p.BookingID == bookingID {
+			delete(s.payments, id)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshTokens[token.TokenID] = token
+	return nil
+}
+
+func (s *Store) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (model.RefreshToken, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, t := range s.refreshTokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+	return model.RefreshToken{}, status.Errorf(codes.NotFound, "refresh token not found")
+}
+
+func (s *Store) RotateRefreshToken(ctx context.Context, oldTokenID string, next model.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, ok := s.refreshTokens[oldTokenID]
+	if !ok || old.RevokedAt != nil {
+		return status.Errorf(codes.Unauthenticated, "refresh token has been revoked")
+	}
+	now := time.Now()
+	old.RevokedAt = &now
+	old.ReplacedBy = &next.TokenID
+	s.refreshTokens[oldTokenID] = old
+	s.refreshTokens[next.TokenID] = next
+	return nil
+}
+
+func (s *Store) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, t := range s.refreshTokens {
+		if t.FamilyID == familyID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+			s.refreshTokens[id] = t
+		}
+	}
+	return nil
+}
+
+func (s *Store) RevokeSubjectRefreshTokens(ctx context.Context, subjectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for id, t := range s.refreshTokens {
+		if t.SubjectID == subjectID && t.RevokedAt == nil {
+			t.RevokedAt = &now
+			s.refreshTokens[id] = t
+		}
+	}
+	return nil
+}
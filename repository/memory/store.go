@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"eventpass/model"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Store is an in-memory implementation of every repository interface. It is
+// meant for tests and for running the service without Postgres; all data is
+// lost on restart.
+type Store struct {
+	mu            sync.RWMutex
+	users         map[string]model.User
+	admins        map[string]model.Admin
+	events        map[string]model.Event
+	tiers         map[string]model.TicketTier
+	bookings      map[string]model.Booking
+	refreshTokens map[string]model.RefreshToken
+	userTokens    map[string]model.UserToken
+	mfaFactors    map[string]model.MFAFactor
+	// Recovery code hashes per subject, mapped to whether they were used
+	recoveryCodes map[string]map[string]bool
+	throttles     map[string]model.LoginThrottle
+	// Keyed by memberKey(eventID, userID)
+	eventMembers map[string]model.EventMember
+	orgs         map[string]model.Organization
+	// Keyed by memberKey(orgID, userID)
+	orgMembers map[string]model.OrgMember
+	apiKeys    map[string]model.APIKey
+	// Keyed by the hash of the state parameter
+	oidcStates map[string]model.OIDCLoginState
+	// Keyed by memberKey(provider, subject)
+	identities map[string]model.UserIdentity
+	payments   map[string]model.Payment
+	// Keyed by memberKey(provider, eventID)
+	paymentEvents map[string]bool
+	holds         map[string]model.SeatHold
+}
+
+func NewStore() *Store {
+	return &Store{
+		users:         map[string]model.User{},
+		admins:        map[string]model.Admin{},
+		events:        map[string]model.Event{},
+		tiers:         map[string]model.TicketTier{},
+		bookings:      map[string]model.Booking{},
+		refreshTokens: map[string]model.RefreshToken{},
+		userTokens:    map[string]model.UserToken{},
+		mfaFactors:    map[string]model.MFAFactor{},
+		recoveryCodes: map[string]map[string]bool{},
+		throttles:     map[string]model.LoginThrottle{},
+		eventMembers:  map[string]model.EventMember{},
+		orgs: map[string]model.Organization{
+			model.DefaultOrgID: {OrgID: model.DefaultOrgID, Slug: "default", Name: "EventPass", CreatedAt: time.Now()},
+		},
+		orgMembers:    map[string]model.OrgMember{},
+		apiKeys:       map[string]model.APIKey{},
+		oidcStates:    map[string]model.OIDCLoginState{},
+		identities:    map[string]model.UserIdentity{},
+		payments:      map[string]model.Payment{},
+		paymentEvents: map[string]bool{},
+		holds:         map[string]model.SeatHold{},
+	}
+}
+
+// alreadyExists mirrors the error the Postgres store returns for a unique
+// constraint violation, so clients see the same details on either backend.
+func alreadyExists(table, field string) error {
+	st := status.New(codes.AlreadyExists, field+" already exists")
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: "UNIQUE_VIOLATION",
+			Domain: "eventpass",
+			Metadata: map[string]string{
+				"table":      table,
+				"constraint": table + "_" + field + "_key",
+				"field":      field,
+			},
+		},
+		&errdetails.BadRequest{FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: "is already taken"},
+		}},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
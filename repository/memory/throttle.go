@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) GetLoginThrottle(ctx context.Context, key string) (model.LoginThrottle, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	throttle, ok := s.throttles[key]
+	if !ok {
+		return model.LoginThrottle{}, status.Errorf(codes.NotFound, "no failed logins")
+	}
+	return throttle, nil
+}
+
+func (s *Store) RecordLoginFailure(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	throttle, ok := s.throttles[key]
+	if !ok || throttle.LastFailureAt.Before(now.Add(-resetAfter)) {
+		throttle = model.LoginThrottle{Key: key}
+	}
+	throttle.Failures++
+	throttle.LastFailureAt = now
+	s.throttles[key] = throttle
+	return throttle.Failures, nil
+}
+
+func (s *Store) LockLogin(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if throttle, ok := s.throttles[key]; ok {
+		throttle.LockedUntil = &until
+		s.throttles[key] = throttle
+	}
+	return nil
+}
+
+func (s *Store) ClearLoginThrottle(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.throttles[key]; !ok {
+		return status.Errorf(codes.NotFound, "no failed logins")
+	}
+	delete(s.throttles, key)
+	return nil
+}
+
+func (s *Store) ListLoginThrottles(ctx context.Context, lockedOnly bool, now time.Time, limit, offset int) ([]model.LoginThrottle, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []model.LoginThrottle
+	for _, t := range s.throttles {
+		if lockedOnly && (t.LockedUntil == nil || !t.LockedUntil.After(now)) {
+			continue
+		}
+		matched = append(matched, t)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].LastFailureAt.Equal(matched[j].LastFailureAt) {
+			return matched[i].LastFailureAt.After(matched[j].LastFailureAt)
+		}
+		return matched[i].Key < matched[j].Key
+	})
+
+	total := len(matched)
+	if offset >= total {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	return matched[offset:end], total, nil
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateUser(ctx context.Context, userID, email, password, firstName, lastName, username, phone string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range s.users {
+		if u.Username == username {
+			return alreadyExists("users", "username")
+		}
+		if u.Email == email {
+			return alreadyExists("users", "email")
+		}
+	}
+	s.users[userID] = model.User{
+		UserID:    userID,
+		FirstName: firstName,
+		LastName:  lastName,
+		Username:  username,
+		Password:  password,
+		Phone:     phone,
+		Email:     email,
+		CreatedAt: time.Now(),
+		// Same default as the users table
+		Role: "customer",
+	}
+	return nil
+}
+
+func (s *Store) GetUser(ctx context.Context, userID string) (model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return model.User{}, status.Errorf(codes.NotFound, "user not found")
+	}
+	return user, nil
+}
+
+func (s *Store) GetUserByUsername(ctx context.Context, username string) (model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return model.User{}, status.Errorf(codes.NotFound, "user not found")
+}
+
+func (s *Store) GetUserByEmail(ctx context.Context, email string) (model.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, u := range s.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return model.User{}, status.Errorf(codes.NotFound, "user not found")
+}
+
+func (s *Store) UpdatePassword(ctx context.Context, userID, password string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	user.Password = password
+	s.users[userID] = user
+	return nil
+}
+
+func (s *Store) MarkEmailVerified(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.EmailVerifiedAt == nil {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+		s.users[userID] = user
+	}
+	return nil
+}
+
+func (s *Store) UpdateProfile(ctx context.Context, userID string, update model.ProfileUpdate) (model.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return model.User{}, status.Errorf(codes.NotFound, "user not found")
+	}
+	if update.FirstName != nil {
+		user.FirstName = *update.FirstName
+	}
+	if update.LastName != nil {
+		user.LastName = *update.LastName
+	}
+	if update.Phone != nil {
+		user.Phone = *update.Phone
+	}
+	s.users[userID] = user
+	return user, nil
+}
+
+func (s *Store) ChangeEmail(ctx context.Context, userID, email string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	for _, u := range s.users {
+		if u.UserID != userID && u.Email == email {
+			return alreadyExists("users", "email")
+		}
+	}
+	user.Email = email
+	user.EmailVerifiedAt = nil
+	s.users[userID] = user
+
+	now := time.Now()
+	for id, t := range s.userTokens {
+		if t.UserID == userID && t.Purpose == model.PurposeEmailVerification && t.UsedAt == nil {
+			t.UsedAt = &now
+			s.userTokens[id] = t
+		}
+	}
+	return nil
+}
+
+func (s *Store) DeleteUser(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	for _, e := range s.events {
+		if e.CreatedBy == userID {
+			return status.Errorf(codes.FailedPrecondition, "delete the events you organize first")
+		}
+	}
+	for _, p := range s.payments {
+		if s.bookings[p.BookingID].UserID == userID && model.PaidFor(p.Status) {
+			return status.Errorf(codes.FailedPrecondition, "accounts with paid bookings cannot be deleted")
+		}
+	}
+	for id, b := range s.bookings {
+		if b.UserID != userID {
+			continue
+		}
+		// Give back the slots of events that have not happened yet
+		if event, ok := s.events[b.EventID]; ok && model.HoldsSlots(b.Status) && event.Status != model.EventCompleted {
+			s.releaseSlots(b)
+		}
+		s.deletePayment(id)
+		delete(s.bookings, id)
+	}
+	for id, h := range s.holds {
+		if h.UserID == userID {
+			s.returnSlots(h.EventID, h.TierID, h.Quantity)
+			delete(s.holds, id)
+		}
+	}
+	for id, t := range s.refreshTokens {
+		if t.SubjectID == userID {
+			delete(s.refreshTokens, id)
+		}
+	}
+	for key, identity := range s.identities {
+		if identity.UserID == userID {
+			delete(s.identities, key)
+		}
+	}
+	for id, k := range s.apiKeys {
+		if k.OwnerID == userID {
+			delete(s.apiKeys, id)
+		}
+	}
+	for id, t := range s.userTokens {
+		if t.UserID == userID {
+			delete(s.userTokens, id)
+		}
+	}
+	for key, m := range s.eventMembers {
+		if m.UserID == userID {
+			delete(s.eventMembers, key)
+		}
+	}
+	for key, m := range s.orgMembers {
+		if m.UserID == userID {
+			delete(s.orgMembers, key)
+		}
+	}
+	delete(s.mfaFactors, userID)
+	delete(s.recoveryCodes, userID)
+	delete(s.users, userID)
+	return nil
+}
+
+func (s *Store) ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	search := strings.ToLower(filter.Search)
+	var matched []model.User
+	for _, u := range s.users {
+		if search != "" && !strings.Contains(strings.ToLower(u.Username), search) && !strings.Contains(strings.ToLower(u.Email), search) {
+			continue
+		}
+		if filter.Role != "" && u.Role != filter.Role {
+			continue
+		}
+		if !filter.CreatedFrom.IsZero() && u.CreatedAt.Before(filter.CreatedFrom) {
+			continue
+		}
+		if !filter.CreatedTo.IsZero() && !u.CreatedAt.Before(filter.CreatedTo) {
+			continue
+		}
+		matched = append(matched, u)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		if !matched[i].CreatedAt.Equal(matched[j].CreatedAt) {
+			return matched[i].CreatedAt.After(matched[j].CreatedAt)
+		}
+		return matched[i].UserID < matched[j].UserID
+	})
+
+	total := len(matched)
+	if filter.Offset >= total {
+		return nil, total, nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > total {
+		end = total
+	}
+	return matched[filter.Offset:end], total, nil
+}
+
+func (s *Store) SuspendUser(ctx context.Context, userID, reason string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.SuspendedAt != nil {
+		return status.Errorf(codes.FailedPrecondition, "user is already suspended")
+	}
+	user.SuspendedAt = &at
+	user.SuspendReason = reason
+	s.users[userID] = user
+	return nil
+}
+
+func (s *Store) ReactivateUser(ctx context.Context, userID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	if user.SuspendedAt == nil {
+		return status.Errorf(codes.FailedPrecondition, "user is not suspended")
+	}
+	user.SuspendedAt = nil
+	user.SuspendReason = ""
+	s.users[userID] = user
+	return nil
+}
+
+func (s *Store) SetUserRole(ctx context.Context, userID, role string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.users[userID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "user not found")
+	}
+	user.Role = role
+	s.users[userID] = user
+	return nil
+}
+
+func (s *Store) CreateAdmin(ctx context.Context, adminID, email, password, firstName, lastName, username, phone string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.admins {
+		if a.Username == username {
+			return alreadyExists("admins", "username")
+		}
+		if a.Email == email {
+			return alreadyExists("admins", "email")
+		}
+	}
+	s.admins[adminID] = model.Admin{
+		AdminID:   adminID,
+		FirstName: firstName,
+		LastName:  lastName,
+		Username:  username,
+		Password:  password,
+		Phone:     phone,
+		Email:     email,
+		CreatedAt: time.Now(),
+	}
+	return nil
+}
+
+func (s *Store) GetAdminById(ctx context.Context, adminID string) (model.Admin, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	admin, ok := s.admins[adminID]
+	if !ok {
+		return model.Admin{}, status.Errorf(codes.NotFound, "admin not found")
+	}
+	return admin, nil
+}
+
+func (s *Store) GetAdminByUsername(ctx context.Context, username string) (model.Admin, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, a := range s.admins {
+		if a.Username == username {
+			return a, nil
+		}
+	}
+	return model.Admin{}, status.Errorf(codes.NotFound, "admin not found")
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"eventpass/model"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Store) CreateUserToken(ctx context.Context, token model.UserToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.userTokens[token.TokenID] = token
+	return nil
+}
+
+func (s *Store) ConsumeUserToken(ctx context.Context, purpose, tokenHash string) (model.UserToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for _, t := range s.userTokens {
+		if t.TokenHash != tokenHash || t.Purpose != purpose || t.UsedAt != nil || !t.ExpiresAt.After(now) {
+			continue
+		}
+		// Older links for the same purpose stop working too
+		for id, other := range s.userTokens {
+			if other.UserID == t.UserID && other.Purpose == purpose && other.UsedAt == nil {
+				other.UsedAt = &now
+				s.userTokens[id] = other
+			}
+		}
+		t.UsedAt = &now
+		return t, nil
+	}
+	return model.UserToken{}, status.Errorf(codes.NotFound, "token not found")
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"eventpass/validate"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BootstrapAdmin creates the first admin account from ADMIN_USERNAME,
+// ADMIN_PASSWORD and ADMIN_EMAIL (plus optional ADMIN_FIRST_NAME,
+// ADMIN_LAST_NAME and ADMIN_PHONE). It does nothing when the variables are
+// unset or an admin with that username already exists, so it is safe to run
+// on every boot.
+func BootstrapAdmin(ctx context.Context, users intf.UserRepository) error {
+	username := os.Getenv("ADMIN_USERNAME")
+	password := os.Getenv("ADMIN_PASSWORD")
+	email := os.Getenv("ADMIN_EMAIL")
+	if username == "" || password == "" {
+		return nil
+	}
+	if email == "" {
+		return fmt.Errorf("ADMIN_EMAIL is required when ADMIN_USERNAME is set")
+	}
+
+	_, err := users.GetAdminByUsername(ctx, username)
+	if err == nil {
+		return nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return fmt.Errorf("failed to look up admin: %w", err)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash admin password: %w", err)
+	}
+
+	firstName := os.Getenv("ADMIN_FIRST_NAME")
+	if firstName == "" {
+		firstName = "Admin"
+	}
+	err = users.CreateAdmin(ctx, uuid.New().String(), email, string(hashedPassword),
+		firstName, os.Getenv("ADMIN_LAST_NAME"), username, os.Getenv("ADMIN_PHONE"))
+	if err != nil {
+		return fmt.Errorf("failed to create admin: %w", err)
+	}
+
+	log.Printf("✅ Created admin %q", username)
+	return nil
+}
+
+type AdminHandler struct {
+	gen.UnimplementedAdminServiceServer
+	users      intf.UserRepository
+	sessions   intf.SessionRepository
+	userTokens intf.UserTokenRepository
+	throttles  intf.LoginThrottleRepository
+	mail       mailer.Mailer
+}
+
+func NewAdminHandler(users intf.UserRepository, sessions intf.SessionRepository, userTokens intf.UserTokenRepository, throttles intf.LoginThrottleRepository, mail mailer.Mailer) *AdminHandler {
+	return &AdminHandler{users: users, sessions: sessions, userTokens: userTokens, throttles: throttles, mail: mail}
+}
+
+func (h *AdminHandler) ListUsers(ctx context.Context, req *gen.ListUsersRequest) (*gen.ListUsersResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPageSize
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = int(req.Page-1) * limit
+	}
+
+	filter := model.UserFilter{Search: req.Search, Role: req.Role, Limit: limit, Offset: offset}
+	// Dates were checked by the validator; the range includes created_to
+	if day, err := time.Parse(validate.DateLayout, req.CreatedFrom); err == nil {
+		filter.CreatedFrom = day
+	}
+	if day, err := time.Parse(validate.DateLayout, req.CreatedTo); err == nil {
+		filter.CreatedTo = day.AddDate(0, 0, 1)
+	}
+
+	users, total, err := h.users.ListUsers(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list users: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list users")
+	}
+
+	resp := &gen.ListUsersResponse{Total: int32(total)}
+	for _, user := range users {
+		resp.Users = append(resp.Users, adminUserToProto(user))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) GetUser(ctx context.Context, req *gen.GetUserRequest) (*gen.GetUserResponse, error) {
+	user, err := h.getUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.GetUserResponse{User: adminUserToProto(user)}, nil
+}
+
+func (h *AdminHandler) SuspendUser(ctx context.Context, req *gen.SuspendUserRequest) (*gen.SuspendUserResponse, error) {
+	err := h.users.SuspendUser(ctx, req.UserId, req.Reason, time.Now())
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to suspend user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to suspend user")
+	}
+
+	// Sessions end now; the login check keeps them out
+	if err := h.sessions.RevokeSubjectRefreshTokens(ctx, req.UserId); err != nil {
+		log.Printf("Failed to revoke sessions: %v", err)
+	}
+	log.Printf("User %s suspended: %s", req.UserId, req.Reason)
+
+	user, err := h.getUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.SuspendUserResponse{
+		Message: "User suspended",
+		User:    adminUserToProto(user),
+	}, nil
+}
+
+func (h *AdminHandler) ReactivateUser(ctx context.Context, req *gen.ReactivateUserRequest) (*gen.ReactivateUserResponse, error) {
+	err := h.users.ReactivateUser(ctx, req.UserId)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to reactivate user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reactivate user")
+	}
+	log.Printf("User %s reactivated", req.UserId)
+
+	user, err := h.getUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.ReactivateUserResponse{
+		Message: "User reactivated",
+		User:    adminUserToProto(user),
+	}, nil
+}
+
+func (h *AdminHandler) ResetUserPassword(ctx context.Context, req *gen.ResetUserPasswordRequest) (*gen.ResetUserPasswordResponse, error) {
+	user, err := h.getUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replace the password with one nobody knows, so only the link works
+	random, _, err := auth.NewOpaqueToken()
+	if err != nil {
+		log.Printf("Failed to generate password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(random), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to process password")
+	}
+	if err := h.users.UpdatePassword(ctx, user.UserID, string(hashedPassword)); err != nil {
+		log.Printf("Failed to update password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+	if err := h.sessions.RevokeSubjectRefreshTokens(ctx, user.UserID); err != nil {
+		log.Printf("Failed to revoke sessions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+
+	// The user can always ask for another link themselves
+	if err := sendPasswordReset(ctx, h.userTokens, h.mail, user); err != nil {
+		log.Printf("Failed to send password reset: %v", err)
+		return &gen.ResetUserPasswordResponse{
+			Message: "Password reset, but the reset email could not be sent",
+		}, nil
+	}
+
+	return &gen.ResetUserPasswordResponse{
+		Message: "Password reset; a reset link was sent to the user",
+	}, nil
+}
+
+func (h *AdminHandler) SetUserRole(ctx context.Context, req *gen.SetUserRoleRequest) (*gen.SetUserRoleResponse, error) {
+	err := h.users.SetUserRole(ctx, req.UserId, req.Role)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to set user role: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to set user role")
+	}
+
+	// Tokens carry the role, so make the user sign in again
+	if err := h.sessions.RevokeSubjectRefreshTokens(ctx, req.UserId); err != nil {
+		log.Printf("Failed to revoke sessions: %v", err)
+	}
+	log.Printf("User %s role set to %s", req.UserId, req.Role)
+
+	user, err := h.getUser(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &gen.SetUserRoleResponse{
+		Message: "User role updated",
+		User:    adminUserToProto(user),
+	}, nil
+}
+
+func (h *AdminHandler) getUser(ctx context.Context, userID string) (model.User, error) {
+	user, err := h.users.GetUser(ctx, userID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.User{}, err
+		}
+		log.Printf("Failed to get user: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to get user")
+	}
+	return user, nil
+}
+
+func (h *AdminHandler) ListLockouts(ctx context.Context, req *gen.ListLockoutsRequest) (*gen.ListLockoutsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPageSize
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = int(req.Page-1) * limit
+	}
+
+	throttles, total, err := h.throttles.ListLoginThrottles(ctx, req.LockedOnly, time.Now().UTC(), limit, offset)
+	if err != nil {
+		log.Printf("Failed to list login throttles: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list lockouts")
+	}
+
+	resp := &gen.ListLockoutsResponse{Total: int32(total)}
+	for _, throttle := range throttles {
+		resp.Lockouts = append(resp.Lockouts, lockoutToProto(throttle))
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) ClearLockout(ctx context.Context, req *gen.ClearLockoutRequest) (*gen.ClearLockoutResponse, error) {
+	err := h.throttles.ClearLoginThrottle(ctx, req.Key)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to clear login throttle: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to clear lockout")
+	}
+
+	log.Printf("Lockout %q cleared", req.Key)
+	return &gen.ClearLockoutResponse{Message: "Lockout cleared"}, nil
+}
+
+func adminUserToProto(user model.User) *gen.User {
+	resp := &gen.User{
+		UserId:        user.UserID,
+		Username:      user.Username,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		Phone:         user.Phone,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		CreatedAt:     user.CreatedAt.Format(time.RFC3339),
+		Suspended:     user.SuspendedAt != nil,
+		SuspendReason: user.SuspendReason,
+	}
+	if user.SuspendedAt != nil {
+		resp.SuspendedAt = user.SuspendedAt.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func lockoutToProto(throttle model.LoginThrottle) *gen.Lockout {
+	lockout := &gen.Lockout{
+		Key:           throttle.Key,
+		Failures:      int32(throttle.Failures),
+		LastFailureAt: throttle.LastFailureAt.Format(time.RFC3339),
+	}
+	if throttle.LockedUntil != nil {
+		lockout.LockedUntil = throttle.LockedUntil.Format(time.RFC3339)
+	}
+	return lockout
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Keys look like ep_<random>; the first apiKeyPrefixLength characters
+	// are stored in the clear so owners can tell them apart
+	apiKeyPrefix       = "ep_"
+	apiKeyPrefixLength = 11
+	// last_used_at is only written when it is older than this, so a busy
+	// kiosk doesn't update the row on every call
+	apiKeyTouchInterval = time.Minute
+)
+
+// apiKeyPermissions are the named scopes an API key can be given.
+var apiKeyPermissions = map[string][]string{
+	"events:read": {
+		gen.EventService_GetEventDetails_FullMethodName,
+		gen.EventService_ListEvents_FullMethodName,
+		gen.EventService_ListMyEvents_FullMethodName,
+		gen.EventService_ListEventAttendees_FullMethodName,
+		gen.EventService_ListEventMembers_FullMethodName,
+	},
+	"events:write": {
+		gen.EventService_CreateEvent_FullMethodName,
+		gen.EventService_UpdateEvent_FullMethodName,
+		gen.EventService_PublishEvent_FullMethodName,
+		gen.EventService_CancelEvent_FullMethodName,
+		gen.EventService_DeleteEvent_FullMethodName,
+	},
+	"bookings:checkin": {
+		gen.BookingService_GetBooking_FullMethodName,
+		gen.BookingService_CheckInBooking_FullMethodName,
+	},
+}
+
+// apiKeyServices are the services whose RPCs may also be scoped one by one.
+// Key management and sign-in are deliberately left out.
+var apiKeyServices = []string{
+	gen.EventService_ServiceDesc.ServiceName,
+	gen.BookingService_ServiceDesc.ServiceName,
+}
+
+// apiKeyScopeMethods returns the full method names a scope covers.
+func apiKeyScopeMethods(scope string) ([]string, bool) {
+	if methods, ok := apiKeyPermissions[scope]; ok {
+		return methods, true
+	}
+	for _, service := range apiKeyServices {
+		if _, ok := AccessPolicy[scope]; ok && strings.HasPrefix(scope, "/"+service+"/") {
+			return []string{scope}, true
+		}
+	}
+	return nil, false
+}
+
+// APIKeyAuthenticator lets the auth interceptor accept API keys. A key acts
+// as its owner, so suspending or deleting the owner stops their keys too.
+type APIKeyAuthenticator struct {
+	keys  intf.APIKeyRepository
+	users intf.UserRepository
+}
+
+func NewAPIKeyAuthenticator(keys intf.APIKeyRepository, users intf.UserRepository) *APIKeyAuthenticator {
+	return &APIKeyAuthenticator{keys: keys, users: users}
+}
+
+func (a *APIKeyAuthenticator) VerifyAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	apiKey, err := a.keys.GetAPIKeyByHash(ctx, auth.HashToken(key))
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid API key")
+		}
+		log.Printf("Failed to get API key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check API key")
+	}
+	if apiKey.RevokedAt != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "API key has been revoked")
+	}
+
+	role, err := a.ownerRole(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyTouchInterval {
+		if err := a.keys.TouchAPIKey(ctx, apiKey.KeyID, now); err != nil {
+			log.Printf("Failed to record API key use: %v", err)
+		}
+	}
+
+	scopes := map[string]bool{}
+	for _, scope := range apiKey.Scopes {
+		methods, _ := apiKeyScopeMethods(scope)
+		for _, method := range methods {
+			scopes[method] = true
+		}
+	}
+	return &auth.Principal{ID: apiKey.OwnerID, Role: role, APIKeyID: apiKey.KeyID, Scopes: scopes}, nil
+}
+
+// ownerRole is the current role of the key's owner.
+func (a *APIKeyAuthenticator) ownerRole(ctx context.Context, apiKey model.APIKey) (string, error) {
+	if apiKey.OwnerRole == auth.RoleAdmin {
+		if _, err := a.users.GetAdminById(ctx, apiKey.OwnerID); err != nil {
+			if status.Code(err) == codes.NotFound {
+				return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+			}
+			log.Printf("Failed to get admin: %v", err)
+			return "", status.Errorf(codes.Internal, "failed to check API key")
+		}
+		return auth.RoleAdmin, nil
+	}
+
+	user, err := a.users.GetUser(ctx, apiKey.OwnerID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", status.Errorf(codes.Unauthenticated, "invalid API key")
+		}
+		log.Printf("Failed to get user: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to check API key")
+	}
+	if user.SuspendedAt != nil {
+		return "", status.Errorf(codes.PermissionDenied, "account is suspended")
+	}
+	return userRole(user), nil
+}
+
+type APIKeyHandler struct {
+	gen.UnimplementedApiKeyServiceServer
+	keys intf.APIKeyRepository
+}
+
+func NewAPIKeyHandler(keys intf.APIKeyRepository) *APIKeyHandler {
+	return &APIKeyHandler{keys: keys}
+}
+
+func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req *gen.CreateApiKeyRequest) (*gen.CreateApiKeyResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, _, err := auth.NewOpaqueToken()
+	if err != nil {
+		log.Printf("Failed to generate API key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create API key")
+	}
+	key := apiKeyPrefix + secret
+
+	apiKey := model.APIKey{
+		KeyID:     uuid.New().String(),
+		Name:      req.Name,
+		Prefix:    key[:apiKeyPrefixLength],
+		KeyHash:   auth.HashToken(key),
+		Scopes:    req.Scopes,
+		OwnerID:   caller.ID,
+		OwnerRole: caller.Role,
+		CreatedAt: time.Now(),
+	}
+	if err := h.keys.CreateAPIKey(ctx, apiKey); err != nil {
+		log.Printf("Failed to create API key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create API key")
+	}
+	log.Printf("API key %s created by %s", apiKey.KeyID, caller.ID)
+
+	return &gen.CreateApiKeyResponse{
+		Message: "API key created. Copy it now, it will not be shown again.",
+		ApiKey:  apiKeyToProto(apiKey),
+		Key:     key,
+	}, nil
+}
+
+func (h *APIKeyHandler) ListApiKeys(ctx context.Context, req *gen.ListApiKeysRequest) (*gen.ListApiKeysResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID := caller.ID
+	if req.All {
+		if !caller.IsAdmin() {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins can list every API key")
+		}
+		ownerID = ""
+	}
+
+	keys, err := h.keys.ListAPIKeys(ctx, ownerID, req.IncludeRevoked)
+	if err != nil {
+		log.Printf("Failed to list API keys: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list API keys")
+	}
+
+	resp := &gen.ListApiKeysResponse{}
+	for _, k := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyToProto(k))
+	}
+	return resp, nil
+}
+
+func (h *APIKeyHandler) RevokeApiKey(ctx context.Context, req *gen.RevokeApiKeyRequest) (*gen.RevokeApiKeyResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Other people's keys don't exist unless the caller is an admin
+	apiKey, err := h.keys.GetAPIKey(ctx, req.KeyId)
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("Failed to get API key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key")
+	}
+	if err != nil || (apiKey.OwnerID != caller.ID && !caller.IsAdmin()) {
+		return nil, status.Errorf(codes.NotFound, "API key not found")
+	}
+
+	if err := h.keys.RevokeAPIKey(ctx, apiKey.KeyID, time.Now()); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to revoke API key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key")
+	}
+	log.Printf("API key %s revoked by %s", apiKey.KeyID, caller.ID)
+
+	return &gen.RevokeApiKeyResponse{
+		Message: "API key revoked",
+	}, nil
+}
+
+func apiKeyToProto(key model.APIKey) *gen.ApiKey {
+	resp := &gen.ApiKey{
+		KeyId:     key.KeyID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		OwnerId:   key.OwnerID,
+		CreatedAt: key.CreatedAt.Format(time.RFC3339),
+	}
+	if key.LastUsedAt != nil {
+		resp.LastUsedAt = key.LastUsedAt.Format(time.RFC3339)
+	}
+	if key.RevokedAt != nil {
+		resp.RevokedAt = key.RevokedAt.Format(time.RFC3339)
+	}
+	return resp
+}
@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/payment"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type BookingHandler struct {
+	gen.UnimplementedBookingServiceServer
+	bookings   intf.BookingRepository
+	users      intf.UserRepository
+	guard      eventGuard
+	payments   intf.PaymentRepository
+	holds      intf.SeatHoldRepository
+	provider   payment.Provider
+	policy     AuthPolicy
+	holdPolicy HoldPolicy
+}
+
+func NewBookingHandler(bookings intf.BookingRepository, users intf.UserRepository, events intf.EventRepository, members intf.EventMemberRepository, orgs intf.OrganizationRepository, payments intf.PaymentRepository, holds intf.SeatHoldRepository, provider payment.Provider, policy AuthPolicy, holdPolicy HoldPolicy) *BookingHandler {
+	return &BookingHandler{bookings: bookings, users: users, guard: eventGuard{events: events, members: members, orgs: orgs}, payments: payments, holds: holds, provider: provider, policy: policy, holdPolicy: holdPolicy}
+}
+
+func (h *BookingHandler) BookEvent(ctx context.Context, req *gen.BookEventRequest) (*gen.BookEventResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkAccount(ctx, caller); err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.guard.checkBrowse(ctx, org); err != nil {
+		return nil, err
+	}
+
+	// Take the tickets of the caller's seat hold, or pick the ticket tier;
+	// drafts and other organizations' events are reported as not found by
+	// the repository below
+	now := time.Now().UTC()
+	tierID, quantity, soldAt := req.TierId, max(int(req.Quantity), 1), now
+	var hold model.SeatHold
+	if req.HoldId != "" {
+		if hold, err = h.getOwnHold(ctx, req.HoldId); err != nil {
+			return nil, err
+		}
+		if hold.EventID != req.EventId {
+			return nil, status.Errorf(codes.InvalidArgument, "hold_id is for another event")
+		}
+		// The sale window applied when the seats were held
+		tierID, quantity, soldAt = hold.TierID, hold.Quantity, hold.CreatedAt
+	}
+	tiers, err := h.guard.events.ListTicketTiers(ctx, org.OrgID, []string{req.EventId})
+	if err != nil {
+		log.Printf("Failed to list ticket tiers: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to book event")
+	}
+	tier, err := chooseTier(tiers, tierID, quantity, soldAt)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate booking ID
+	bookingID := uuid.New().String()
+
+	// Paid tickets are only held until the payment comes through
+	var expiresAt *time.Time
+	if tier.PriceMinor > 0 {
+		paymentExpiry := now.Add(paymentHoldTTL)
+		expiresAt = &paymentExpiry
+	}
+
+	// Reserve the slots, or take over the hold's, and create the booking
+	if hold.HoldID != "" {
+		err = h.bookings.BookSeatHold(ctx, org.OrgID, bookingID, hold.HoldID, now, expiresAt)
+	} else {
+		err = h.bookings.CreateBooking(ctx, org.OrgID, bookingID, req.EventId, caller.ID, tier.TierID, quantity, expiresAt)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to create booking: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to book event")
+	}
+
+	if expiresAt == nil {
+		return &gen.BookEventResponse{
+			Message:   "Event booked successfully",
+			BookingId: bookingID,
+			Status:    model.BookingConfirmed,
+		}, nil
+	}
+
+	pay, err := h.startPayment(ctx, bookingID, req.EventId, tier, quantity, *expiresAt)
+	if err != nil {
+		// Give the slots back rather than hold them for a payment that
+		// can never be made
+		if err := h.bookings.CancelBooking(ctx, org.OrgID, bookingID); err != nil {
+			log.Printf("Failed to release booking %s: %v", bookingID, err)
+		}
+		return nil, err
+	}
+	return &gen.BookEventResponse{
+		Message:   "Booking reserved; complete the payment to confirm it",
+		BookingId: bookingID,
+		Status:    model.BookingPendingPayment,
+		Payment:   pay,
+	}, nil
+}
+
+func (h *BookingHandler) ListMyBookings(ctx context.Context, req *gen.ListMyBookingsRequest) (*gen.ListMyBookingsResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPageSize
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = int(req.Page-1) * limit
+	}
+
+	bookings, total, err := h.bookings.ListBookingsByUser(ctx, org.OrgID, caller.ID, req.Status, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list bookings: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list bookings")
+	}
+
+	resp := &gen.ListMyBookingsResponse{
+		Bookings: make([]*gen.GetBookingResponse, 0, len(bookings)),
+		Total:    int32(total),
+	}
+	for _, booking := range bookings {
+		resp.Bookings = append(resp.Bookings, bookingToProto(booking))
+	}
+	return resp, nil
+}
+
+func (h *BookingHandler) GetBooking(ctx context.Context, req *gen.GetBookingRequest) (*gen.GetBookingResponse, error) {
+	booking, err := h.getOwnBooking(ctx, req.BookingId)
+	if status.Code(err) == codes.NotFound {
+		// The event's team can look up its bookings too
+		booking, err = h.getEventBooking(ctx, req.BookingId, viewEvent)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return bookingToProto(booking), nil
+}
+
+func (h *BookingHandler) CancelBooking(ctx context.Context, req *gen.CancelBookingRequest) (*gen.CancelBookingResponse, error) {
+	booking, err := h.getOwnBooking(ctx, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status == model.BookingPendingPayment {
+		if err := h.cancelPayment(ctx, booking.BookingID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Cancel and release the slot back to the event
+	if err := h.bookings.CancelBooking(ctx, booking.Event.OrgID, req.BookingId); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to cancel booking: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel booking")
+	}
+
+	return &gen.CancelBookingResponse{
+		Message: "Booking cancelled successfully",
+	}, nil
+}
+
+// getOwnBooking loads a booking of the request's organization and hides it
+// from anyone but its owner and admins.
+func (h *BookingHandler) getOwnBooking(ctx context.Context, bookingID string) (model.Booking, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return model.Booking{}, err
+	}
+
+	booking, err := h.bookings.GetBooking(ctx, org.OrgID, bookingID)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return model.Booking{}, err
+		}
+		log.Printf("Failed to get booking: %v", err)
+		return model.Booking{}, status.Errorf(codes.Internal, "failed to get booking")
+	}
+	if booking.UserID != caller.ID && !caller.IsAdmin() {
+		return model.Booking{}, status.Errorf(codes.NotFound, "booking not found")
+	}
+	return booking, nil
+}
+
+// checkAccount rejects suspended users, and users whose email is unverified
+// unless the policy lets them book anyway. Admins are not in the users table,
+// so they cannot own bookings or holds at all.
+func (h *BookingHandler) checkAccount(ctx context.Context, caller *auth.Principal) error {
+	if caller.IsAdmin() {
+		return status.Errorf(codes.PermissionDenied, "admin accounts cannot book; sign in with a user account")
+	}
+	user, err := h.users.GetUser(ctx, caller.ID)
+	if err != nil {
+		log.Printf("Failed to get user: %v", err)
+		return status.Errorf(codes.Internal, "failed to book event")
+	}
+	if user.SuspendedAt != nil {
+		return status.Errorf(codes.PermissionDenied, "account is suspended")
+	}
+	if user.EmailVerifiedAt == nil && !h.policy.AllowUnverifiedBooking {
+		return status.Errorf(codes.FailedPrecondition, "verify your email address before booking")
+	}
+	return nil
+}
+
+func (h *BookingHandler) CheckInBooking(ctx context.Context, req *gen.CheckInBookingRequest) (*gen.CheckInBookingResponse, error) {
+	booking, err := h.getEventBooking(ctx, req.BookingId, checkInEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err = h.bookings.CheckInBooking(ctx, booking.Event.OrgID, req.BookingId, time.Now())
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to check in booking: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to check in booking")
+	}
+
+	return &gen.CheckInBookingResponse{
+		Message: "Checked in",
+		Booking: bookingToProto(booking),
+	}, nil
+}
+
+// getEventBooking loads a booking for a member of its event's team who may
+// perform action. Callers off the team get NotFound, as for other people's
+// bookings.
+func (h *BookingHandler) getEventBooking(ctx context.Context, bookingID string, action eventAction) (model.Booking, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return model.Booking{}, err
+	}
+
+	booking, err := h.bookings.GetBooking(ctx, org.OrgID, bookingID)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return model.Booking{}, err
+		}
+		log.Printf("Failed to get booking: %v", err)
+		return model.Booking{}, status.Errorf(codes.Internal, "failed to get booking")
+	}
+	event, err := h.guard.events.GetEvent(ctx, org.OrgID, booking.EventID)
+	if err != nil {
+		log.Printf("Failed to get event: %v", err)
+		return model.Booking{}, status.Errorf(codes.Internal, "failed to get booking")
+	}
+
+	role, err := h.guard.role(ctx, caller, org, event)
+	if err != nil {
+		return model.Booking{}, err
+	}
+	if role == "" {
+		return model.Booking{}, status.Errorf(codes.NotFound, "booking not found")
+	}
+	if memberRank[role] < action {
+		return model.Booking{}, status.Errorf(codes.PermissionDenied, "your role on this event does not allow that")
+	}
+	return booking, nil
+}
+
+func bookingToProto(booking model.Booking) *gen.GetBookingResponse {
+	resp := &gen.GetBookingResponse{
+		BookingId:        booking.BookingID,
+		EventId:          booking.EventID,
+		UserId:           booking.UserID,
+		Status:           booking.Status,
+		CreatedAt:        booking.CreatedAt.Format(time.RFC3339),
+		EventTitle:       booking.Event.Event_Title,
+		EventDescription: booking.Event.Event_Description,
+		EventLocation:    booking.Event.Event_Location,
+		EventDate:        booking.Event.Event_Date.Format("2006-01-02"),
+		EventStartTime:   booking.Event.Event_Start_Time.Format("15:04:05"),
+		EventEndTime:     booking.Event.Event_End_Time.Format("15:04:05"),
+		CancelReason:     booking.CancelReason,
+		TierId:           booking.TierID,
+		TierName:         booking.TierName,
+		Quantity:         int32(booking.Quantity),
+		UnitPriceMinor:   booking.UnitPrice,
+		Currency:         booking.Currency,
+		TotalPriceMinor:  booking.UnitPrice * int64(booking.Quantity),
+	}
+	if booking.CancelledAt != nil {
+		resp.CancelledAt = booking.CancelledAt.Format(time.RFC3339)
+	}
+	if booking.CheckedInAt != nil {
+		resp.CheckedInAt = booking.CheckedInAt.Format(time.RFC3339)
+	}
+	if booking.ExpiresAt != nil {
+		resp.ExpiresAt = booking.ExpiresAt.Format(time.RFC3339)
+	}
+	return resp
+}
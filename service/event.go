@@ -3,30 +3,85 @@ package service
 
 import (
 	"context"
-	pgx "eventpass/pgx"
+	"encoding/base64"
+	"encoding/json"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/payment"
 	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
 	"log"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
 type EventHandler struct {
 	gen.UnimplementedEventServiceServer
+	events   intf.EventRepository
+	bookings intf.BookingRepository
+	members  intf.EventMemberRepository
+	users    intf.UserRepository
+	orgs     intf.OrganizationRepository
+	payments intf.PaymentRepository
+	provider payment.Provider
+	mail     mailer.Mailer
+	guard    eventGuard
 }
 
-func NewEventHandler() *EventHandler {
-	return &EventHandler{}
+func NewEventHandler(events intf.EventRepository, bookings intf.BookingRepository, members intf.EventMemberRepository, users intf.UserRepository, orgs intf.OrganizationRepository, payments intf.PaymentRepository, provider payment.Provider, mail mailer.Mailer) *EventHandler {
+	return &EventHandler{
+		events:   events,
+		bookings: bookings,
+		members:  members,
+		users:    users,
+		orgs:     orgs,
+		payments: payments,
+		provider: provider,
+		mail:     mail,
+		guard:    eventGuard{events: events, members: members, orgs: orgs},
+	}
 }
 
 func (h *EventHandler) CreateEvent(ctx context.Context, req *gen.CreateEventRequest) (*gen.CreateEventResponse, error) {
+	// The event belongs to whoever is signed in
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the organization's organizers may add events to it
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := orgRole(ctx, h.orgs, caller, org)
+	if err != nil {
+		return nil, err
+	}
+	if orgRank[role] < orgRank[model.OrgRoleOrganizer] {
+		return nil, status.Errorf(codes.PermissionDenied, "only the organization's organizers can create events")
+	}
+	if err := checkSlotCap(org, int(req.TotalSlots)); err != nil {
+		return nil, err
+	}
+
 	// Generate event ID
 	eventID := uuid.New().String()
+	tiers := ticketTiersFromProto(req.TicketTiers)
 
 	// Create event in database
-	err := pgx.CreateEvent(
+	err = h.events.CreateEvent(
 		ctx,
+		org.OrgID,
 		eventID,
 		req.EventTitle,
 		req.EventDescription,
@@ -34,27 +89,424 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *gen.CreateEventRequ
 		req.EventDate,
 		req.EventStartTime,
 		req.EventEndTime,
-		req.CreatedBy,
+		caller.ID,
 		req.TotalSlots,
+		tiers,
 	)
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		log.Printf("Failed to create event: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to create event")
 	}
 
 	return &gen.CreateEventResponse{
-		Message: "Event created successfully",
+		Message:     "Event created successfully",
+		EventId:     eventID,
+		TicketTiers: tiersToProto(tiers),
 	}, nil
 }
 
 func (h *EventHandler) GetEventDetails(ctx context.Context, req *gen.GetEventRequest) (*gen.GetEventResponse, error) {
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get event from database
-	event, err := pgx.GetEvent(ctx, req.EventId)
+	event, err := h.events.GetEvent(ctx, org.OrgID, req.EventId)
 	if err != nil {
 		log.Printf("Failed to get event: %v", err)
 		return nil, status.Errorf(codes.NotFound, "event not found")
 	}
 
+	// The event's team always sees it, even in a members-only organization;
+	// drafts don't exist as far as anyone else is concerned
+	if !h.guard.canSeeDraft(ctx, org, event) {
+		if event.Status == model.EventDraft {
+			return nil, status.Errorf(codes.NotFound, "event not found")
+		}
+		if err := h.guard.checkBrowse(ctx, org); err != nil {
+			return nil, err
+		}
+	}
+
+	tiers, err := h.loadTiers(ctx, org.OrgID, event)
+	if err != nil {
+		return nil, err
+	}
+	return eventToProto(event, tiers[event.Event_ID]), nil
+}
+
+func (h *EventHandler) ListEvents(ctx context.Context, req *gen.ListEventsRequest) (*gen.ListEventsResponse, error) {
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.guard.checkBrowse(ctx, org); err != nil {
+		return nil, err
+	}
+
+	filter := model.EventFilter{
+		OrgID:        org.OrgID,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Location:     req.Location,
+		CreatedBy:    req.CreatedBy,
+		HasFreeSlots: req.HasFreeSlots,
+		SortBy:       req.SortBy,
+		Descending:   req.SortOrder == "desc",
+		Limit:        int(req.Limit),
+	}
+	if filter.SortBy == "" {
+		filter.SortBy = "date"
+	}
+
+	caller, _ := auth.PrincipalFromContext(ctx)
+	switch req.Status {
+	case "":
+		if caller == nil || !caller.IsAdmin() {
+			filter.Statuses = []string{model.EventPublished, model.EventCancelled, model.EventCompleted}
+		}
+	case model.EventDraft:
+		if caller == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "only organizers can list draft events")
+		}
+		// Organizers only see drafts of their own events; org admins see all
+		role, err := orgRole(ctx, h.orgs, caller, org)
+		if err != nil {
+			return nil, err
+		}
+		if role != model.OrgRoleAdmin {
+			filter.MemberID = caller.ID
+		}
+		filter.Statuses = []string{req.Status}
+	default:
+		filter.Statuses = []string{req.Status}
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPageSize
+	} else if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+
+	// Keyset pagination takes precedence over page numbers
+	if req.PageToken != "" {
+		cursor, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page_token")
+		}
+		if cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending {
+			return nil, status.Errorf(codes.InvalidArgument, "page_token does not match sort")
+		}
+		filter.Cursor = cursor
+	} else if req.Page > 1 {
+		filter.Offset = int(req.Page-1) * filter.Limit
+	}
+
+	events, total, err := h.events.ListEvents(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list events: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list events")
+	}
+
+	tiers, err := h.loadTiers(ctx, org.OrgID, events...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gen.ListEventsResponse{
+		Events: make([]*gen.GetEventResponse, 0, len(events)),
+		Total:  int32(total),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, eventToProto(event, tiers[event.Event_ID]))
+	}
+	if len(events) == filter.Limit {
+		cursor := events[len(events)-1].Cursor(filter.SortBy)
+		cursor.Descending = filter.Descending
+		resp.NextPageToken = encodePageToken(cursor)
+	}
+	return resp, nil
+}
+
+func (h *EventHandler) UpdateEvent(ctx context.Context, req *gen.UpdateEventRequest) (*gen.UpdateEventResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, editEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := eventUpdateFromProto(req.Event, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+	if update.TotalSlots != nil {
+		org, err := currentOrg(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSlotCap(org, *update.TotalSlots); err != nil {
+			return nil, err
+		}
+	}
+
+	event, err = h.events.UpdateEvent(ctx, event.OrgID, event.Event_ID, int(req.Version), update)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to update event: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update event")
+	}
+
+	tiers, err := h.loadTiers(ctx, event.OrgID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.UpdateEventResponse{
+		Message: "Event updated successfully",
+		Event:   eventToProto(event, tiers[event.Event_ID]),
+	}, nil
+}
+
+func (h *EventHandler) DeleteEvent(ctx context.Context, req *gen.DeleteEventRequest) (*gen.DeleteEventResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, manageEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unpaid bookings go with the event; make sure they stay unpaid
+	if err := cancelOpenPayments(ctx, h.payments, h.provider, event.Event_ID, ""); err != nil {
+		return nil, err
+	}
+
+	if err := h.events.DeleteEvent(ctx, event.OrgID, event.Event_ID, req.Force); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to delete event: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete event")
+	}
+
+	return &gen.DeleteEventResponse{
+		Message: "Event deleted successfully",
+	}, nil
+}
+
+func (h *EventHandler) PublishEvent(ctx context.Context, req *gen.PublishEventRequest) (*gen.PublishEventResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, editEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err = h.events.PublishEvent(ctx, event.OrgID, event.Event_ID)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to publish event: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to publish event")
+	}
+
+	tiers, err := h.loadTiers(ctx, event.OrgID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.PublishEventResponse{
+		Message: "Event published successfully",
+		Event:   eventToProto(event, tiers[event.Event_ID]),
+	}, nil
+}
+
+func (h *EventHandler) CancelEvent(ctx context.Context, req *gen.CancelEventRequest) (*gen.CancelEventResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, manageEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stop unpaid bookings from being paid, then cancel them with the rest
+	// of the event's bookings in one transaction
+	if err := cancelOpenPayments(ctx, h.payments, h.provider, event.Event_ID, ""); err != nil {
+		return nil, err
+	}
+
+	event, cancelled, err := h.events.CancelEvent(ctx, event.OrgID, event.Event_ID, req.Reason)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to cancel event: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to cancel event")
+	}
+
+	tiers, err := h.loadTiers(ctx, event.OrgID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.CancelEventResponse{
+		Message:           "Event cancelled successfully",
+		Event:             eventToProto(event, tiers[event.Event_ID]),
+		CancelledBookings: int32(cancelled),
+	}, nil
+}
+
+func (h *EventHandler) ListMyEvents(ctx context.Context, req *gen.ListMyEventsRequest) (*gen.ListEventsResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := model.EventFilter{
+		OrgID:      org.OrgID,
+		MemberID:   caller.ID,
+		SortBy:     "created_at",
+		Descending: true,
+		Limit:      int(req.Limit),
+	}
+	if req.Status != "" {
+		filter.Statuses = []string{req.Status}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultPageSize
+	} else if filter.Limit > maxPageSize {
+		filter.Limit = maxPageSize
+	}
+	if req.Page > 1 {
+		filter.Offset = int(req.Page-1) * filter.Limit
+	}
+
+	events, total, err := h.events.ListEvents(ctx, filter)
+	if err != nil {
+		log.Printf("Failed to list events: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list events")
+	}
+
+	tiers, err := h.loadTiers(ctx, org.OrgID, events...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gen.ListEventsResponse{
+		Events: make([]*gen.GetEventResponse, 0, len(events)),
+		Total:  int32(total),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, eventToProto(event, tiers[event.Event_ID]))
+	}
+	return resp, nil
+}
+
+func (h *EventHandler) ListEventAttendees(ctx context.Context, req *gen.ListEventAttendeesRequest) (*gen.ListEventAttendeesResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, viewEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultPageSize
+	} else if limit > maxPageSize {
+		limit = maxPageSize
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = int(req.Page-1) * limit
+	}
+
+	attendees, total, err := h.bookings.ListAttendees(ctx, event.OrgID, event.Event_ID, req.Status, limit, offset)
+	if err != nil {
+		log.Printf("Failed to list attendees: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list attendees")
+	}
+
+	resp := &gen.ListEventAttendeesResponse{
+		Attendees: make([]*gen.Attendee, 0, len(attendees)),
+		Total:     int32(total),
+	}
+	for _, a := range attendees {
+		resp.Attendees = append(resp.Attendees, &gen.Attendee{
+			BookingId: a.BookingID,
+			UserId:    a.UserID,
+			Username:  a.Username,
+			FirstName: a.FirstName,
+			LastName:  a.LastName,
+			Email:     a.Email,
+			Status:    a.Status,
+			BookedAt:  a.BookedAt.Format(time.RFC3339),
+			TierName:  a.TierName,
+			Quantity:  int32(a.Quantity),
+		})
+		if a.CheckedInAt != nil {
+			resp.Attendees[len(resp.Attendees)-1].CheckedInAt = a.CheckedInAt.Format(time.RFC3339)
+		}
+	}
+	return resp, nil
+}
+
+// eventUpdateFromProto picks the fields named in paths out of fields. With no
+// paths every non-empty field is used.
+func eventUpdateFromProto(fields *gen.EventUpdate, paths []string) (model.EventUpdate, error) {
+	var update model.EventUpdate
+	if fields == nil {
+		fields = &gen.EventUpdate{}
+	}
+
+	if len(paths) == 0 {
+		for _, p := range []struct {
+			path string
+			set  bool
+		}{
+			{"event_title", fields.EventTitle != ""},
+			{"event_description", fields.EventDescription != ""},
+			{"event_location", fields.EventLocation != ""},
+			{"event_date", fields.EventDate != ""},
+			{"event_start_time", fields.EventStartTime != ""},
+			{"event_end_time", fields.EventEndTime != ""},
+			{"total_slots", fields.TotalSlots != 0},
+		} {
+			if p.set {
+				paths = append(paths, p.path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return update, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "event_title":
+			update.Title = &fields.EventTitle
+		case "event_description":
+			update.Description = &fields.EventDescription
+		case "event_location":
+			update.Location = &fields.EventLocation
+		case "event_date":
+			update.Date = &fields.EventDate
+		case "event_start_time":
+			update.StartTime = &fields.EventStartTime
+		case "event_end_time":
+			update.EndTime = &fields.EventEndTime
+		case "total_slots":
+			totalSlots := int(fields.TotalSlots)
+			update.TotalSlots = &totalSlots
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+	return update, nil
+}
+
+func eventToProto(event model.Event, tiers []model.TicketTier) *gen.GetEventResponse {
 	return &gen.GetEventResponse{
 		EventId:          event.Event_ID,
 		EventTitle:       event.Event_Title,
@@ -65,5 +517,37 @@ func (h *EventHandler) GetEventDetails(ctx context.Context, req *gen.GetEventReq
 		EventEndTime:     event.Event_End_Time.Format("15:04:05"),
 		CreatedBy:        event.CreatedBy,
 		TotalSlots:       int32(event.TotalSlots),
-	}, nil
+		AvailableSlots:   int32(event.TotalSlots - event.BookedSlots),
+		Version:          int32(event.Version),
+		Status:           event.Status,
+		CancelReason:     event.CancelReason,
+		OrgId:            event.OrgID,
+		TicketTiers:      tiersToProto(tiers),
+	}
+}
+
+// checkSlotCap enforces the organization's max_event_slots setting.
+func checkSlotCap(org model.Organization, totalSlots int) error {
+	if org.MaxEventSlots > 0 && totalSlots > org.MaxEventSlots {
+		return status.Errorf(codes.FailedPrecondition, "total_slots cannot exceed the organization's limit of %d", org.MaxEventSlots)
+	}
+	return nil
+}
+
+// Page tokens are opaque to clients: base64 of the JSON-encoded cursor.
+func encodePageToken(cursor model.EventCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(token string) (*model.EventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	var cursor model.EventCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
 }
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *BookingHandler) CreateSeatHold(ctx context.Context, req *gen.CreateSeatHoldRequest) (*gen.CreateSeatHoldResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkAccount(ctx, caller); err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.guard.checkBrowse(ctx, org); err != nil {
+		return nil, err
+	}
+
+	// Holds follow the same tier rules as bookings
+	now := time.Now().UTC()
+	quantity := max(int(req.Quantity), 1)
+	tiers, err := h.guard.events.ListTicketTiers(ctx, org.OrgID, []string{req.EventId})
+	if err != nil {
+		log.Printf("Failed to list ticket tiers: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to hold seats")
+	}
+	tier, err := chooseTier(tiers, req.TierId, quantity, now)
+	if err != nil {
+		return nil, err
+	}
+
+	hold := model.SeatHold{
+		HoldID:    uuid.New().String(),
+		EventID:   req.EventId,
+		TierID:    tier.TierID,
+		UserID:    caller.ID,
+		Quantity:  quantity,
+		ExpiresAt: now.Add(h.holdPolicy.TTL),
+		CreatedAt: now,
+	}
+	if err := h.holds.CreateSeatHold(ctx, org.OrgID, hold); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to create seat hold: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to hold seats")
+	}
+
+	return &gen.CreateSeatHoldResponse{
+		Message: "Seats held",
+		Hold:    holdToProto(hold),
+	}, nil
+}
+
+func (h *BookingHandler) ExtendSeatHold(ctx context.Context, req *gen.ExtendSeatHoldRequest) (*gen.ExtendSeatHoldResponse, error) {
+	hold, err := h.getOwnHold(ctx, req.HoldId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restart the clock, but never past the maximum age
+	now := time.Now().UTC()
+	expiresAt := now.Add(h.holdPolicy.TTL)
+	if limit := hold.CreatedAt.Add(h.holdPolicy.MaxAge); expiresAt.After(limit) {
+		expiresAt = limit
+	}
+	if !expiresAt.After(hold.ExpiresAt) {
+		return nil, status.Errorf(codes.FailedPrecondition, "seat hold cannot be extended any further")
+	}
+
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hold, err = h.holds.ExtendSeatHold(ctx, org.OrgID, hold.HoldID, expiresAt, now)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to extend seat hold: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to extend seat hold")
+	}
+
+	return &gen.ExtendSeatHoldResponse{
+		Message: "Seat hold extended",
+		Hold:    holdToProto(hold),
+	}, nil
+}
+
+func (h *BookingHandler) ReleaseSeatHold(ctx context.Context, req *gen.ReleaseSeatHoldRequest) (*gen.ReleaseSeatHoldResponse, error) {
+	hold, err := h.getOwnHold(ctx, req.HoldId)
+	if err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.holds.ReleaseSeatHold(ctx, org.OrgID, hold.HoldID); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to release seat hold: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to release seat hold")
+	}
+
+	return &gen.ReleaseSeatHoldResponse{
+		Message: "Seat hold released",
+	}, nil
+}
+
+// getOwnHold loads a seat hold of the request's organization and hides it
+// from anyone but its owner and admins.
+func (h *BookingHandler) getOwnHold(ctx context.Context, holdID string) (model.SeatHold, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.SeatHold{}, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return model.SeatHold{}, err
+	}
+
+	hold, err := h.holds.GetSeatHold(ctx, org.OrgID, holdID)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return model.SeatHold{}, err
+		}
+		log.Printf("Failed to get seat hold: %v", err)
+		return model.SeatHold{}, status.Errorf(codes.Internal, "failed to get seat hold")
+	}
+	if hold.UserID != caller.ID && !caller.IsAdmin() {
+		return model.SeatHold{}, status.Errorf(codes.NotFound, "seat hold not found")
+	}
+	return hold, nil
+}
+
+func holdToProto(hold model.SeatHold) *gen.SeatHold {
+	return &gen.SeatHold{
+		HoldId:    hold.HoldID,
+		EventId:   hold.EventID,
+		TierId:    hold.TierID,
+		Quantity:  int32(hold.Quantity),
+		ExpiresAt: hold.ExpiresAt.Format(time.RFC3339),
+		CreatedAt: hold.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// Failures allowed before an account or an IP address gets locked
+	accountFailureLimit = 5
+	ipFailureLimit      = 20
+	// The lock doubles with every failure past the limit, up to maxLockout
+	baseLockout = 30 * time.Second
+	maxLockout  = time.Hour
+	// Failure counts start over after a quiet day
+	failureWindow = 24 * time.Hour
+)
+
+// dummyHash is compared against when the account does not exist, so unknown
+// usernames take as long to reject as wrong passwords.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("eventpass-dummy-password"), bcrypt.DefaultCost)
+
+// loginKeys names the throttles a sign-in attempt counts against.
+type loginKeys struct {
+	account string
+	ip      string
+}
+
+func userLoginKeys(ctx context.Context, userID, username string) loginKeys {
+	account := "user:" + strings.ToLower(username)
+	if userID != "" {
+		account = "user:" + userID
+	}
+	return loginKeys{account: account, ip: ipKey(ctx)}
+}
+
+func adminLoginKeys(ctx context.Context, adminID, username string) loginKeys {
+	account := "admin:" + strings.ToLower(username)
+	if adminID != "" {
+		account = "admin:" + adminID
+	}
+	return loginKeys{account: account, ip: ipKey(ctx)}
+}
+
+func ipKey(ctx context.Context) string {
+	if ip := clientIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
+	return ""
+}
+
+// clientIP returns the caller's address. Requests relayed by the local
+// gateway carry the browser's address in x-forwarded-for.
+func clientIP(ctx context.Context) string {
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+	if ip := net.ParseIP(addr); ip == nil || !ip.IsLoopback() {
+		return addr
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			hops := strings.Split(values[len(values)-1], ",")
+			if forwarded := strings.TrimSpace(hops[len(hops)-1]); forwarded != "" {
+				return forwarded
+			}
+		}
+	}
+	return addr
+}
+
+// checkLockout rejects the attempt while any of the keys is locked.
+func (h *UserHandler) checkLockout(ctx context.Context, keys ...string) error {
+	now := time.Now().UTC()
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		throttle, err := h.throttles.GetLoginThrottle(ctx, key)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				log.Printf("Failed to get login throttle: %v", err)
+			}
+			continue
+		}
+		if throttle.LockedUntil != nil && throttle.LockedUntil.After(now) {
+			return lockedError(throttle.LockedUntil.Sub(now))
+		}
+	}
+	return nil
+}
+
+// recordFailure counts a failed attempt against each key and locks the keys
+// that went over their limit.
+func (h *UserHandler) recordFailure(ctx context.Context, keys loginKeys) {
+	now := time.Now().UTC()
+	limits := map[string]int{keys.account: accountFailureLimit, keys.ip: ipFailureLimit}
+	for key, limit := range limits {
+		if key == "" {
+			continue
+		}
+		failures, err := h.throttles.RecordLoginFailure(ctx, key, now, failureWindow)
+		if err != nil {
+			log.Printf("Failed to record login failure: %v", err)
+			continue
+		}
+		if failures < limit {
+			continue
+		}
+		if err := h.throttles.LockLogin(ctx, key, now.Add(lockoutFor(failures-limit))); err != nil {
+			log.Printf("Failed to lock login: %v", err)
+		}
+	}
+}
+
+// clearFailures forgets the failures of an account after a successful login.
+// The IP counter is left alone so one good account can't reset it.
+func (h *UserHandler) clearFailures(ctx context.Context, key string) {
+	err := h.throttles.ClearLoginThrottle(ctx, key)
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("Failed to clear login throttle: %v", err)
+	}
+}
+
+func lockoutFor(extra int) time.Duration {
+	lock := baseLockout
+	for i := 0; i < extra && lock < maxLockout; i++ {
+		lock *= 2
+	}
+	return min(lock, maxLockout)
+}
+
+func lockedError(retryAfter time.Duration) error {
+	retryAfter = retryAfter.Round(time.Second)
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("too many failed attempts, try again in %s", retryAfter))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventAction is something a member may do on an event.
+type eventAction int
+
+const (
+	viewEvent    eventAction = iota + 1 // drafts, attendees and the team
+	checkInEvent                        // check attendees in at the door
+	editEvent                           // update, publish, manage staff and viewers
+	manageEvent                         // cancel, delete, manage owners and co-organizers
+)
+
+// memberRank orders the roles: each role may do everything the ones below it
+// can, and nothing more than the action of the same rank.
+var memberRank = map[string]eventAction{
+	model.MemberViewer:       viewEvent,
+	model.MemberCheckInStaff: checkInEvent,
+	model.MemberCoOrganizer:  editEvent,
+	model.MemberOwner:        manageEvent,
+}
+
+// eventGuard decides what callers may do on an event of the request's
+// organization based on who created it and its accepted members. Admins and
+// the organization's admins may do anything.
+type eventGuard struct {
+	events  intf.EventRepository
+	members intf.EventMemberRepository
+	orgs    intf.OrganizationRepository
+}
+
+// authorize loads the event and checks the caller may perform action on it.
+// Callers with no role at all get NotFound for drafts, like the public.
+func (g eventGuard) authorize(ctx context.Context, eventID string, action eventAction) (model.Event, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.Event{}, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return model.Event{}, err
+	}
+
+	event, err := g.events.GetEvent(ctx, org.OrgID, eventID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.Event{}, err
+		}
+		log.Printf("Failed to get event: %v", err)
+		return model.Event{}, status.Errorf(codes.Internal, "failed to get event")
+	}
+
+	role, err := g.role(ctx, caller, org, event)
+	if err != nil {
+		return model.Event{}, err
+	}
+	if role == "" && event.Status == model.EventDraft {
+		return model.Event{}, status.Errorf(codes.NotFound, "event not found")
+	}
+	if memberRank[role] < action {
+		return model.Event{}, status.Errorf(codes.PermissionDenied, "your role on this event does not allow that")
+	}
+	return event, nil
+}
+
+// role returns the caller's role on the event, or "" if they have none.
+func (g eventGuard) role(ctx context.Context, caller *auth.Principal, org model.Organization, event model.Event) (string, error) {
+	if caller.IsAdmin() || event.CreatedBy == caller.ID {
+		return model.MemberOwner, nil
+	}
+	callerOrgRole, err := orgRole(ctx, g.orgs, caller, org)
+	if err != nil {
+		return "", err
+	}
+	if callerOrgRole == model.OrgRoleAdmin {
+		return model.MemberOwner, nil
+	}
+
+	member, err := g.members.GetEventMember(ctx, org.OrgID, event.Event_ID, caller.ID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", nil
+		}
+		log.Printf("Failed to get event member: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to check event access")
+	}
+	if member.AcceptedAt == nil {
+		return "", nil
+	}
+	return member.Role, nil
+}
+
+// canSeeDraft reports whether the caller has any role on the event.
+func (g eventGuard) canSeeDraft(ctx context.Context, org model.Organization, event model.Event) bool {
+	caller, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return false
+	}
+	role, err := g.role(ctx, caller, org, event)
+	return err == nil && role != ""
+}
+
+// checkBrowse lets anyone see the events of an open organization, and only
+// its members those of a members-only one.
+func (g eventGuard) checkBrowse(ctx context.Context, org model.Organization) error {
+	if !org.MembersOnly {
+		return nil
+	}
+	caller, _ := auth.PrincipalFromContext(ctx)
+	role, err := orgRole(ctx, g.orgs, caller, org)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		return status.Errorf(codes.PermissionDenied, "this organization's events are for its members only")
+	}
+	return nil
+}
+
+func (h *EventHandler) InviteEventMember(ctx context.Context, req *gen.InviteEventMemberRequest) (*gen.InviteEventMemberResponse, error) {
+	// Co-organizers can bring in staff; only owners can hand out their level
+	event, err := h.guard.authorize(ctx, req.EventId, memberAction(req.Role))
+	if err != nil {
+		return nil, err
+	}
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var invitee model.User
+	if req.Username != "" {
+		invitee, err = h.users.GetUserByUsername(ctx, req.Username)
+	} else {
+		invitee, err = h.users.GetUserByEmail(ctx, req.Email)
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		log.Printf("Failed to get user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to invite member")
+	}
+	if invitee.UserID == event.CreatedBy {
+		return nil, status.Errorf(codes.FailedPrecondition, "the event's creator is already its owner")
+	}
+
+	member := model.EventMember{
+		EventID:   event.Event_ID,
+		UserID:    invitee.UserID,
+		Role:      req.Role,
+		InvitedBy: caller.ID,
+		InvitedAt: time.Now(),
+	}
+	if err := h.members.AddEventMember(ctx, event.OrgID, member); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to add event member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to invite member")
+	}
+
+	err = h.mail.Send(ctx, mailer.Message{
+		To:      invitee.Email,
+		Subject: fmt.Sprintf("You are invited to help run %s", event.Event_Title),
+		Body: fmt.Sprintf("Hi %s,\n\nYou have been invited to join %q on EventPass as %s. Sign in and accept the invitation to get access.\n\nEvent ID: %s\n",
+			invitee.FirstName, event.Event_Title, req.Role, event.Event_ID),
+	})
+	if err != nil {
+		log.Printf("Failed to send invitation email: %v", err)
+	}
+
+	member.Username = invitee.Username
+	member.Email = invitee.Email
+	return &gen.InviteEventMemberResponse{
+		Message: "Invitation sent",
+		Member:  memberToProto(member),
+	}, nil
+}
+
+func (h *EventHandler) AcceptEventInvite(ctx context.Context, req *gen.AcceptEventInviteRequest) (*gen.AcceptEventInviteResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.members.AcceptEventMember(ctx, org.OrgID, req.EventId, caller.ID, time.Now()); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to accept invitation: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation")
+	}
+
+	member, err := h.members.GetEventMember(ctx, org.OrgID, req.EventId, caller.ID)
+	if err != nil {
+		log.Printf("Failed to get event member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to accept invitation")
+	}
+	return &gen.AcceptEventInviteResponse{
+		Message: "Invitation accepted",
+		Member:  memberToProto(member),
+	}, nil
+}
+
+func (h *EventHandler) RemoveEventMember(ctx context.Context, req *gen.RemoveEventMemberRequest) (*gen.RemoveEventMemberResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := currentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.members.GetEventMember(ctx, org.OrgID, req.EventId, req.UserId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "member not found")
+		}
+		log.Printf("Failed to get event member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove member")
+	}
+
+	// Anyone may leave; removing others takes the rank to have invited them
+	if member.UserID != caller.ID {
+		if _, err := h.guard.authorize(ctx, req.EventId, memberAction(member.Role)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.members.RemoveEventMember(ctx, org.OrgID, req.EventId, req.UserId); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to remove event member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove member")
+	}
+
+	return &gen.RemoveEventMemberResponse{
+		Message: "Member removed",
+	}, nil
+}
+
+func (h *EventHandler) ListEventMembers(ctx context.Context, req *gen.ListEventMembersRequest) (*gen.ListEventMembersResponse, error) {
+	event, err := h.guard.authorize(ctx, req.EventId, viewEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.members.ListEventMembers(ctx, event.OrgID, event.Event_ID)
+	if err != nil {
+		log.Printf("Failed to list event members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list members")
+	}
+
+	// The creator has no row; list them first as the owner
+	creator := model.EventMember{
+		EventID:    event.Event_ID,
+		UserID:     event.CreatedBy,
+		Role:       model.MemberOwner,
+		InvitedAt:  event.CreatedAt,
+		AcceptedAt: &event.CreatedAt,
+	}
+	if user, err := h.users.GetUser(ctx, event.CreatedBy); err == nil {
+		creator.Username, creator.Email = user.Username, user.Email
+	} else if admin, err := h.users.GetAdminById(ctx, event.CreatedBy); err == nil {
+		creator.Username, creator.Email = admin.Username, admin.Email
+	}
+
+	resp := &gen.ListEventMembersResponse{
+		Members: []*gen.EventMember{memberToProto(creator)},
+	}
+	for _, m := range members {
+		resp.Members = append(resp.Members, memberToProto(m))
+	}
+	return resp, nil
+}
+
+// memberAction is the action needed to add or remove a member with role.
+func memberAction(role string) eventAction {
+	if memberRank[role] >= editEvent {
+		return manageEvent
+	}
+	return editEvent
+}
+
+func memberToProto(member model.EventMember) *gen.EventMember {
+	resp := &gen.EventMember{
+		UserId:    member.UserID,
+		Username:  member.Username,
+		Email:     member.Email,
+		Role:      member.Role,
+		InvitedBy: member.InvitedBy,
+		InvitedAt: member.InvitedAt.Format(time.RFC3339),
+	}
+	if member.AcceptedAt != nil {
+		resp.AcceptedAt = member.AcceptedAt.Format(time.RFC3339)
+	}
+	return resp
+}
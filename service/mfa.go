@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mfaChallenge is handed out instead of a session when a login needs a
+// second factor.
+type mfaChallenge struct {
+	token string
+	// enroll is set when the subject must first set up an authenticator
+	enroll bool
+}
+
+// loginChallenge decides whether a login whose password checked out still
+// needs a second factor. It returns nil if a session can be started now.
+func (h *UserHandler) loginChallenge(ctx context.Context, subjectID, role string) (*mfaChallenge, error) {
+	factor, err := h.mfa.GetMFAFactor(ctx, subjectID)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	purpose := ""
+	switch {
+	case err == nil && factor.ConfirmedAt != nil:
+		purpose = auth.PurposeMFA
+	case role == auth.RoleAdmin && h.policy.RequireAdminMFA:
+		purpose = auth.PurposeMFAEnroll
+	default:
+		return nil, nil
+	}
+
+	token, _, err := h.tokens.IssueChallengeToken(subjectID, role, purpose)
+	if err != nil {
+		return nil, err
+	}
+	return &mfaChallenge{token: token, enroll: purpose == auth.PurposeMFAEnroll}, nil
+}
+
+// mfaSubject identifies who is managing their factor: the signed-in caller,
+// or the holder of an enrolment token from a login that requires 2FA.
+func (h *UserHandler) mfaSubject(ctx context.Context, mfaToken string) (*auth.Principal, bool, error) {
+	if mfaToken != "" {
+		claims, err := h.tokens.ParseChallengeToken(mfaToken, auth.PurposeMFAEnroll)
+		if err != nil {
+			return nil, false, status.Errorf(codes.Unauthenticated, "invalid or expired mfa token")
+		}
+		return &auth.Principal{ID: claims.Subject, Role: claims.Role}, true, nil
+	}
+	caller, err := principal(ctx)
+	return caller, false, err
+}
+
+func (h *UserHandler) EnrollMFA(ctx context.Context, req *gen.EnrollMFARequest) (*gen.EnrollMFAResponse, error) {
+	subject, _, err := h.mfaSubject(ctx, req.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authenticator apps show the username next to the issuer
+	var accountName string
+	if subject.IsAdmin() {
+		admin, err := h.users.GetAdminById(ctx, subject.ID)
+		if err != nil {
+			log.Printf("Failed to get admin: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to start enrolment")
+		}
+		accountName = admin.Username
+	} else {
+		user, err := h.users.GetUser(ctx, subject.ID)
+		if err != nil {
+			log.Printf("Failed to get user: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to start enrolment")
+		}
+		accountName = user.Username
+	}
+
+	key, err := auth.NewTOTPKey(accountName)
+	if err != nil {
+		log.Printf("Failed to generate TOTP key: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start enrolment")
+	}
+	if err := h.mfa.SaveMFAFactor(ctx, model.MFAFactor{SubjectID: subject.ID, Secret: key.Secret}); err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, err
+		}
+		log.Printf("Failed to save MFA factor: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start enrolment")
+	}
+
+	return &gen.EnrollMFAResponse{
+		Secret:     key.Secret,
+		OtpauthUri: key.URI,
+		QrCodePng:  key.QRCode,
+	}, nil
+}
+
+func (h *UserHandler) ConfirmMFA(ctx context.Context, req *gen.ConfirmMFARequest) (*gen.ConfirmMFAResponse, error) {
+	subject, viaChallenge, err := h.mfaSubject(ctx, req.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, err := h.mfa.GetMFAFactor(ctx, subject.ID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.FailedPrecondition, "call EnrollMFA first")
+		}
+		log.Printf("Failed to get MFA factor: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to confirm enrolment")
+	}
+	if factor.ConfirmedAt != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "two-factor authentication is already enabled")
+	}
+
+	step, ok := auth.VerifyTOTP(factor.Secret, req.Code, time.Now())
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid code")
+	}
+
+	recoveryCodes, err := auth.NewRecoveryCodes()
+	if err != nil {
+		log.Printf("Failed to generate recovery codes: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to confirm enrolment")
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		hashes[i] = auth.HashRecoveryCode(code)
+	}
+	if err := h.mfa.ConfirmMFAFactor(ctx, subject.ID, step, hashes); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to confirm MFA factor: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to confirm enrolment")
+	}
+
+	resp := &gen.ConfirmMFAResponse{
+		Message:       "Two-factor authentication enabled",
+		RecoveryCodes: recoveryCodes,
+	}
+
+	// Enrolment was the last step of a login, so finish it
+	if viaChallenge {
+		session, err := h.startSession(ctx, subject.ID, subject.Role)
+		if err != nil {
+			log.Printf("Failed to start session: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to start session")
+		}
+		resp.AccessToken = session.accessToken
+		resp.RefreshToken = session.refreshToken
+		resp.ExpiresIn = session.expiresIn
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) VerifyMFA(ctx context.Context, req *gen.VerifyMFARequest) (*gen.VerifyMFAResponse, error) {
+	claims, err := h.tokens.ParseChallengeToken(req.MfaToken, auth.PurposeMFA)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid or expired mfa token")
+	}
+
+	// Codes are short, so guesses are throttled like passwords
+	keys := loginKeys{account: "mfa:" + claims.Subject}
+	if err := h.checkLockout(ctx, keys.account); err != nil {
+		return nil, err
+	}
+	if err := h.checkSecondFactor(ctx, claims.Subject, req.Code, req.RecoveryCode); err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			h.recordFailure(ctx, keys)
+		}
+		return nil, err
+	}
+	h.clearFailures(ctx, keys.account)
+
+	session, err := h.startSession(ctx, claims.Subject, claims.Role)
+	if err != nil {
+		log.Printf("Failed to start session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
+	}
+
+	return &gen.VerifyMFAResponse{
+		Message:      "Login successful",
+		SubjectId:    claims.Subject,
+		AccessToken:  session.accessToken,
+		RefreshToken: session.refreshToken,
+		ExpiresIn:    session.expiresIn,
+	}, nil
+}
+
+func (h *UserHandler) DisableMFA(ctx context.Context, req *gen.DisableMFARequest) (*gen.DisableMFAResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if caller.IsAdmin() && h.policy.RequireAdminMFA {
+		return nil, status.Errorf(codes.FailedPrecondition, "two-factor authentication is required for admins")
+	}
+
+	if err := h.checkSecondFactor(ctx, caller.ID, req.Code, ""); err != nil {
+		return nil, err
+	}
+	if err := h.mfa.DeleteMFAFactor(ctx, caller.ID); err != nil {
+		log.Printf("Failed to delete MFA factor: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to disable two-factor authentication")
+	}
+
+	return &gen.DisableMFAResponse{
+		Message: "Two-factor authentication disabled",
+	}, nil
+}
+
+// checkSecondFactor accepts either a current TOTP code, which must not have
+// been used before, or an unused recovery code.
+func (h *UserHandler) checkSecondFactor(ctx context.Context, subjectID, code, recoveryCode string) error {
+	factor, err := h.mfa.GetMFAFactor(ctx, subjectID)
+	if err != nil || factor.ConfirmedAt == nil {
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Printf("Failed to get MFA factor: %v", err)
+		}
+		return status.Errorf(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+
+	if recoveryCode != "" {
+		err = h.mfa.UseRecoveryCode(ctx, subjectID, auth.HashRecoveryCode(recoveryCode))
+	} else if step, ok := auth.VerifyTOTP(factor.Secret, code, time.Now()); ok {
+		err = h.mfa.UseMFAStep(ctx, subjectID, step)
+	} else {
+		return status.Errorf(codes.Unauthenticated, "invalid code")
+	}
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.AlreadyExists:
+		default:
+			log.Printf("Failed to use second factor: %v", err)
+		}
+		return status.Errorf(codes.Unauthenticated, "invalid code")
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/oidc"
+	"eventpass/proto/gen"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long a user has to sign in at the provider and come back
+const oidcLoginTTL = 10 * time.Minute
+
+// Characters validate.Username rejects, stripped from suggested usernames
+var usernameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+func (h *UserHandler) ListOIDCProviders(ctx context.Context, req *gen.ListOIDCProvidersRequest) (*gen.ListOIDCProvidersResponse, error) {
+	resp := &gen.ListOIDCProvidersResponse{}
+	for name := range h.providers {
+		resp.Providers = append(resp.Providers, name)
+	}
+	sort.Strings(resp.Providers)
+	return resp, nil
+}
+
+func (h *UserHandler) StartOIDCLogin(ctx context.Context, req *gen.StartOIDCLoginRequest) (*gen.StartOIDCLoginResponse, error) {
+	provider, ok := h.providers[req.Provider]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown identity provider")
+	}
+
+	state, stateHash, err := auth.NewOpaqueToken()
+	if err != nil {
+		log.Printf("Failed to generate state: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start login")
+	}
+	nonce, _, err := auth.NewOpaqueToken()
+	if err != nil {
+		log.Printf("Failed to generate nonce: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start login")
+	}
+	verifier, challenge, err := oidc.NewPKCE()
+	if err != nil {
+		log.Printf("Failed to generate PKCE verifier: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start login")
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, challenge)
+	if err != nil {
+		log.Printf("Failed to build %s authorization URL: %v", req.Provider, err)
+		return nil, status.Errorf(codes.Unavailable, "identity provider is unavailable")
+	}
+
+	err = h.identities.CreateOIDCLoginState(ctx, model.OIDCLoginState{
+		StateHash:    stateHash,
+		Provider:     req.Provider,
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		ExpiresAt:    time.Now().UTC().Add(oidcLoginTTL),
+	})
+	if err != nil {
+		log.Printf("Failed to store login state: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start login")
+	}
+
+	return &gen.StartOIDCLoginResponse{
+		AuthorizationUrl: authURL,
+		State:            state,
+	}, nil
+}
+
+func (h *UserHandler) CompleteOIDCLogin(ctx context.Context, req *gen.CompleteOIDCLoginRequest) (*gen.LoginResponse, error) {
+	provider, ok := h.providers[req.Provider]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown identity provider")
+	}
+
+	// States are single use, even when the rest of the login fails
+	state, err := h.identities.ConsumeOIDCLoginState(ctx, auth.HashToken(req.State))
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("Failed to get login state: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to log in")
+	}
+	if err != nil || state.Provider != req.Provider || time.Now().UTC().After(state.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "login attempt is unknown or has expired, start again")
+	}
+
+	identity, err := provider.Exchange(ctx, req.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		log.Printf("Failed to complete %s login: %v", req.Provider, err)
+		return nil, status.Errorf(codes.Unauthenticated, "identity provider did not confirm the login")
+	}
+
+	user, err := h.oidcUser(ctx, req.Provider, identity)
+	if err != nil {
+		return nil, err
+	}
+	return h.completeLogin(ctx, user)
+}
+
+// oidcUser finds the user an external identity belongs to: the one it is
+// linked to, else the one with the same verified email, else a new one.
+func (h *UserHandler) oidcUser(ctx context.Context, provider string, identity oidc.Identity) (model.User, error) {
+	linked, err := h.identities.GetUserIdentity(ctx, provider, identity.Subject)
+	if err == nil {
+		user, err := h.users.GetUser(ctx, linked.UserID)
+		if err != nil {
+			log.Printf("Failed to get linked user: %v", err)
+			return model.User{}, status.Errorf(codes.Internal, "failed to log in")
+		}
+		return user, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		log.Printf("Failed to get identity: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to log in")
+	}
+
+	if identity.Email == "" {
+		return model.User{}, status.Errorf(codes.FailedPrecondition, "identity provider did not share an email address")
+	}
+	user, err := h.users.GetUserByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		// Both sides must vouch for the address, or whoever registered it
+		// first here could take over the other account
+		if !identity.EmailVerified {
+			return model.User{}, status.Errorf(codes.FailedPrecondition, "an account with this email already exists; sign in with your password")
+		}
+		if user.EmailVerifiedAt == nil {
+			return model.User{}, status.Errorf(codes.FailedPrecondition, "verify the email address of your existing account before signing in with %s", provider)
+		}
+	case status.Code(err) == codes.NotFound:
+		if user, err = h.createOIDCUser(ctx, identity); err != nil {
+			return model.User{}, err
+		}
+	default:
+		log.Printf("Failed to get user: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to log in")
+	}
+
+	err = h.identities.CreateUserIdentity(ctx, model.UserIdentity{
+		Provider:  provider,
+		Subject:   identity.Subject,
+		UserID:    user.UserID,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		log.Printf("Failed to link identity: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to log in")
+	}
+	log.Printf("Linked %s identity %s to user %s", provider, identity.Subject, user.UserID)
+	return user, nil
+}
+
+// createOIDCUser signs up a user on their first external login. They have
+// no password until they set one with a password reset.
+func (h *UserHandler) createOIDCUser(ctx context.Context, identity oidc.Identity) (model.User, error) {
+	username, err := h.freeUsername(ctx, identity)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user := model.User{
+		UserID:    uuid.New().String(),
+		Username:  username,
+		Email:     identity.Email,
+		FirstName: truncate(identity.GivenName, 100),
+		LastName:  truncate(identity.FamilyName, 100),
+		CreatedAt: time.Now(),
+	}
+	err = h.users.CreateUser(ctx, user.UserID, user.Email, "", user.FirstName, user.LastName, user.Username, "")
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return model.User{}, err
+		}
+		log.Printf("Failed to create user: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to create user")
+	}
+
+	// Addresses the provider vouches for count as verified
+	if identity.EmailVerified {
+		if err := h.users.MarkEmailVerified(ctx, user.UserID); err != nil {
+			log.Printf("Failed to mark email verified: %v", err)
+			return model.User{}, status.Errorf(codes.Internal, "failed to create user")
+		}
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	} else if err := h.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+	}
+	log.Printf("Created user %s from an external login", user.UserID)
+	return user, nil
+}
+
+// freeUsername suggests a username from the identity that is not taken yet.
+func (h *UserHandler) freeUsername(ctx context.Context, identity oidc.Identity) (string, error) {
+	base := identity.PreferredUsername
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	base = truncate(usernameUnsafe.ReplaceAllString(base, ""), 40)
+	if len(base) < 3 {
+		base = "user"
+	}
+
+	candidate := base
+	for range 5 {
+		_, err := h.users.GetUserByUsername(ctx, candidate)
+		if status.Code(err) == codes.NotFound {
+			return candidate, nil
+		}
+		if err != nil {
+			log.Printf("Failed to get user: %v", err)
+			return "", status.Errorf(codes.Internal, "failed to create user")
+		}
+		candidate = fmt.Sprintf("%s-%s", base, uuid.New().String()[:6])
+	}
+	return "", status.Errorf(codes.Internal, "failed to create user")
+}
+
+// truncate cuts s to at most n characters.
+func truncate(s string, n int) string {
+	if runes := []rune(s); len(runes) > n {
+		return string(runes[:n])
+	}
+	return s
+}
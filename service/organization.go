@@ -0,0 +1,396 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"eventpass/tenant"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// orgRank orders the organization roles; each may do what the ones below it
+// can.
+var orgRank = map[string]int{
+	model.OrgRoleMember:    1,
+	model.OrgRoleOrganizer: 2,
+	model.OrgRoleAdmin:     3,
+}
+
+type OrganizationHandler struct {
+	gen.UnimplementedOrganizationServiceServer
+	orgs  intf.OrganizationRepository
+	users intf.UserRepository
+}
+
+func NewOrganizationHandler(orgs intf.OrganizationRepository, users intf.UserRepository) *OrganizationHandler {
+	return &OrganizationHandler{orgs: orgs, users: users}
+}
+
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *gen.CreateOrganizationRequest) (*gen.CreateOrganizationResponse, error) {
+	// Look the owner up first so a typo doesn't leave an orphan behind
+	var owner model.User
+	if req.OwnerUsername != "" {
+		var err error
+		owner, err = h.users.GetUserByUsername(ctx, req.OwnerUsername)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Errorf(codes.NotFound, "owner not found")
+			}
+			log.Printf("Failed to get user: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to create organization")
+		}
+	}
+
+	org := model.Organization{
+		OrgID:         uuid.New().String(),
+		Slug:          req.Slug,
+		Name:          req.Name,
+		LogoURL:       req.LogoUrl,
+		PrimaryColor:  req.PrimaryColor,
+		SupportEmail:  req.SupportEmail,
+		MembersOnly:   req.MembersOnly,
+		MaxEventSlots: int(req.MaxEventSlots),
+		CreatedAt:     time.Now(),
+	}
+	if err := h.orgs.CreateOrganization(ctx, org); err != nil {
+		// A taken slug comes back as AlreadyExists
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to create organization: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to create organization")
+	}
+
+	if owner.UserID != "" {
+		member := model.OrgMember{OrgID: org.OrgID, UserID: owner.UserID, Role: model.OrgRoleAdmin, JoinedAt: time.Now()}
+		if err := h.orgs.AddOrgMember(ctx, member); err != nil {
+			log.Printf("Failed to add organization owner: %v", err)
+			return nil, status.Errorf(codes.Internal, "organization created but adding the owner failed")
+		}
+	}
+
+	return &gen.CreateOrganizationResponse{
+		Message:      "Organization created successfully",
+		Organization: orgToProto(org),
+	}, nil
+}
+
+func (h *OrganizationHandler) GetOrganization(ctx context.Context, req *gen.GetOrganizationRequest) (*gen.Organization, error) {
+	org, err := tenant.Find(ctx, h.orgs, req.OrgId)
+	if err != nil {
+		return nil, err
+	}
+	return orgToProto(org), nil
+}
+
+func (h *OrganizationHandler) ListMyOrganizations(ctx context.Context, req *gen.ListMyOrganizationsRequest) (*gen.ListOrganizationsResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID := caller.ID
+	if caller.IsAdmin() {
+		userID = ""
+	}
+	orgs, err := h.orgs.ListOrganizations(ctx, userID)
+	if err != nil {
+		log.Printf("Failed to list organizations: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list organizations")
+	}
+
+	resp := &gen.ListOrganizationsResponse{
+		Organizations: make([]*gen.Organization, 0, len(orgs)),
+	}
+	for _, org := range orgs {
+		resp.Organizations = append(resp.Organizations, orgToProto(org))
+	}
+	return resp, nil
+}
+
+func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, req *gen.UpdateOrganizationRequest) (*gen.UpdateOrganizationResponse, error) {
+	org, err := h.authorize(ctx, req.OrgId, model.OrgRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := orgUpdateFromProto(req.Organization, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	org, err = h.orgs.UpdateOrganization(ctx, org.OrgID, update)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to update organization: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update organization")
+	}
+
+	return &gen.UpdateOrganizationResponse{
+		Message:      "Organization updated successfully",
+		Organization: orgToProto(org),
+	}, nil
+}
+
+func (h *OrganizationHandler) AddOrganizationMember(ctx context.Context, req *gen.AddOrganizationMemberRequest) (*gen.AddOrganizationMemberResponse, error) {
+	org, err := h.authorize(ctx, req.OrgId, model.OrgRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	var user model.User
+	if req.Username != "" {
+		user, err = h.users.GetUserByUsername(ctx, req.Username)
+	} else {
+		user, err = h.users.GetUserByEmail(ctx, req.Email)
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "user not found")
+		}
+		log.Printf("Failed to get user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to add member")
+	}
+
+	member := model.OrgMember{
+		OrgID:    org.OrgID,
+		UserID:   user.UserID,
+		Role:     req.Role,
+		JoinedAt: time.Now(),
+	}
+	if err := h.orgs.AddOrgMember(ctx, member); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to add organization member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to add member")
+	}
+
+	member.Username = user.Username
+	member.Email = user.Email
+	return &gen.AddOrganizationMemberResponse{
+		Message: "Member added",
+		Member:  orgMemberToProto(member),
+	}, nil
+}
+
+func (h *OrganizationHandler) RemoveOrganizationMember(ctx context.Context, req *gen.RemoveOrganizationMemberRequest) (*gen.RemoveOrganizationMemberResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Anyone may leave; removing others takes an org admin
+	var org model.Organization
+	if req.UserId == caller.ID {
+		org, err = tenant.Find(ctx, h.orgs, req.OrgId)
+	} else {
+		org, err = h.authorize(ctx, req.OrgId, model.OrgRoleAdmin)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.orgs.GetOrgMember(ctx, org.OrgID, req.UserId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "member not found")
+		}
+		log.Printf("Failed to get organization member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove member")
+	}
+	if member.Role == model.OrgRoleAdmin {
+		if err := h.checkOtherAdmin(ctx, org.OrgID, member.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.orgs.RemoveOrgMember(ctx, org.OrgID, req.UserId); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to remove organization member: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to remove member")
+	}
+
+	return &gen.RemoveOrganizationMemberResponse{
+		Message: "Member removed",
+	}, nil
+}
+
+func (h *OrganizationHandler) ListOrganizationMembers(ctx context.Context, req *gen.ListOrganizationMembersRequest) (*gen.ListOrganizationMembersResponse, error) {
+	org, err := h.authorize(ctx, req.OrgId, model.OrgRoleOrganizer)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.orgs.ListOrgMembers(ctx, org.OrgID)
+	if err != nil {
+		log.Printf("Failed to list organization members: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list members")
+	}
+
+	resp := &gen.ListOrganizationMembersResponse{
+		Members: make([]*gen.OrganizationMember, 0, len(members)),
+	}
+	for _, m := range members {
+		resp.Members = append(resp.Members, orgMemberToProto(m))
+	}
+	return resp, nil
+}
+
+// authorize loads the organization named by key and checks the caller holds
+// at least role in it.
+func (h *OrganizationHandler) authorize(ctx context.Context, key, role string) (model.Organization, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.Organization{}, err
+	}
+	org, err := tenant.Find(ctx, h.orgs, key)
+	if err != nil {
+		return model.Organization{}, err
+	}
+
+	callerRole, err := orgRole(ctx, h.orgs, caller, org)
+	if err != nil {
+		return model.Organization{}, err
+	}
+	if orgRank[callerRole] < orgRank[role] {
+		return model.Organization{}, status.Errorf(codes.PermissionDenied, "your role in this organization does not allow that")
+	}
+	return org, nil
+}
+
+// checkOtherAdmin keeps an organization from losing its last admin.
+func (h *OrganizationHandler) checkOtherAdmin(ctx context.Context, orgID, leaving string) error {
+	members, err := h.orgs.ListOrgMembers(ctx, orgID)
+	if err != nil {
+		log.Printf("Failed to list organization members: %v", err)
+		return status.Errorf(codes.Internal, "failed to remove member")
+	}
+	for _, m := range members {
+		if m.Role == model.OrgRoleAdmin && m.UserID != leaving {
+			return nil
+		}
+	}
+	return status.Errorf(codes.FailedPrecondition, "an organization needs at least one admin")
+}
+
+// currentOrg returns the organization the request acts in, attached by the
+// tenant resolver.
+func currentOrg(ctx context.Context) (model.Organization, error) {
+	org, ok := tenant.OrgFromContext(ctx)
+	if !ok {
+		log.Printf("No organization on the request context")
+		return model.Organization{}, status.Errorf(codes.Internal, "organization not resolved")
+	}
+	return org, nil
+}
+
+// orgRole returns the caller's role in the organization, or "" if they have
+// none. Admins act as org admins everywhere, and organizers from before
+// organizations existed keep organizing in the default organization.
+func orgRole(ctx context.Context, orgs intf.OrganizationRepository, caller *auth.Principal, org model.Organization) (string, error) {
+	if caller == nil {
+		return "", nil
+	}
+	if caller.IsAdmin() {
+		return model.OrgRoleAdmin, nil
+	}
+	member, err := orgs.GetOrgMember(ctx, org.OrgID, caller.ID)
+	if err == nil {
+		return member.Role, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		log.Printf("Failed to get organization member: %v", err)
+		return "", status.Errorf(codes.Internal, "failed to check organization access")
+	}
+	if org.OrgID == model.DefaultOrgID && caller.Role == auth.RoleOrganizer {
+		return model.OrgRoleOrganizer, nil
+	}
+	return "", nil
+}
+
+// orgUpdateFromProto picks the fields named in paths out of fields. With no
+// paths every non-empty field is used.
+func orgUpdateFromProto(fields *gen.OrganizationUpdate, paths []string) (model.OrganizationUpdate, error) {
+	var update model.OrganizationUpdate
+	if fields == nil {
+		fields = &gen.OrganizationUpdate{}
+	}
+
+	if len(paths) == 0 {
+		for _, p := range []struct {
+			path string
+			set  bool
+		}{
+			{"name", fields.Name != ""},
+			{"logo_url", fields.LogoUrl != ""},
+			{"primary_color", fields.PrimaryColor != ""},
+			{"support_email", fields.SupportEmail != ""},
+			{"members_only", fields.MembersOnly},
+			{"max_event_slots", fields.MaxEventSlots != 0},
+		} {
+			if p.set {
+				paths = append(paths, p.path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return update, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.Name = &fields.Name
+		case "logo_url":
+			update.LogoURL = &fields.LogoUrl
+		case "primary_color":
+			update.PrimaryColor = &fields.PrimaryColor
+		case "support_email":
+			update.SupportEmail = &fields.SupportEmail
+		case "members_only":
+			update.MembersOnly = &fields.MembersOnly
+		case "max_event_slots":
+			maxSlots := int(fields.MaxEventSlots)
+			update.MaxEventSlots = &maxSlots
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+	return update, nil
+}
+
+func orgToProto(org model.Organization) *gen.Organization {
+	return &gen.Organization{
+		OrgId:         org.OrgID,
+		Slug:          org.Slug,
+		Name:          org.Name,
+		LogoUrl:       org.LogoURL,
+		PrimaryColor:  org.PrimaryColor,
+		SupportEmail:  org.SupportEmail,
+		MembersOnly:   org.MembersOnly,
+		MaxEventSlots: int32(org.MaxEventSlots),
+		CreatedAt:     org.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func orgMemberToProto(member model.OrgMember) *gen.OrganizationMember {
+	return &gen.OrganizationMember{
+		UserId:   member.UserID,
+		Username: member.Username,
+		Email:    member.Email,
+		Role:     member.Role,
+		JoinedAt: member.JoinedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const passwordResetTTL = time.Hour
+
+// RequestPasswordReset mails a reset link if the email belongs to a user. The
+// response is the same either way so it cannot be used to probe for accounts.
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *gen.RequestPasswordResetRequest) (*gen.RequestPasswordResetResponse, error) {
+	resp := &gen.RequestPasswordResetResponse{
+		Message: "If an account exists for that email, a reset link has been sent",
+	}
+
+	user, err := h.users.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Failed to get user: %v", err)
+		}
+		return resp, nil
+	}
+
+	if err := sendPasswordReset(ctx, h.userTokens, h.mail, user); err != nil {
+		log.Printf("Failed to send password reset: %v", err)
+	}
+	return resp, nil
+}
+
+// sendPasswordReset stores a single-use reset token for the user and mails
+// them the link.
+func sendPasswordReset(ctx context.Context, userTokens intf.UserTokenRepository, mail mailer.Mailer, user model.User) error {
+	token, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return err
+	}
+	err = userTokens.CreateUserToken(ctx, model.UserToken{
+		TokenID:   uuid.New().String(),
+		UserID:    user.UserID,
+		Purpose:   model.PurposePasswordReset,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(passwordResetTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return mail.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your EventPass password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password. It expires in %d minutes and can only be used once.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			user.FirstName, int(passwordResetTTL.Minutes()), appURL("reset_token", token)),
+	})
+}
+
+// ConfirmPasswordReset sets a new password and signs the user out everywhere.
+func (h *UserHandler) ConfirmPasswordReset(ctx context.Context, req *gen.ConfirmPasswordResetRequest) (*gen.ConfirmPasswordResetResponse, error) {
+	// Claiming the token first makes it single-use even if the rest fails
+	token, err := h.userTokens.ConsumeUserToken(ctx, model.PurposePasswordReset, auth.HashToken(req.Token))
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Failed to consume reset token: %v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired reset token")
+	}
+
+	// Hash password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to process password")
+	}
+	if err := h.users.UpdatePassword(ctx, token.UserID, string(hashedPassword)); err != nil {
+		log.Printf("Failed to update password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+
+	// Whoever knew the old password may still hold a session
+	if err := h.sessions.RevokeSubjectRefreshTokens(ctx, token.UserID); err != nil {
+		log.Printf("Failed to revoke sessions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to reset password")
+	}
+
+	if user, err := h.users.GetUser(ctx, token.UserID); err == nil {
+		err = h.mail.Send(ctx, mailer.Message{
+			To:      user.Email,
+			Subject: "Your EventPass password was changed",
+			Body:    fmt.Sprintf("Hi %s,\n\nYour password was just reset and you have been signed out of all devices.\n", user.FirstName),
+		})
+		if err != nil {
+			log.Printf("Failed to send password changed email: %v", err)
+		}
+	}
+
+	return &gen.ConfirmPasswordResetResponse{
+		Message: "Password reset successfully",
+	}, nil
+}
+
+// appURL builds a link into the web app, which lives at APP_URL.
+func appURL(param, value string) string {
+	base := os.Getenv("APP_URL")
+	if base == "" {
+		base = "http://localhost:8080"
+	}
+	return base + "/?" + url.Values{param: {value}}.Encode()
+}
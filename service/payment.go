@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"eventpass/model"
+	"eventpass/payment"
+	"eventpass/proto/gen"
+	intf "eventpass/repository/intf"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// paymentHoldTTL is how long a paid booking keeps its slots while the
+// customer pays. Unpaid bookings are expired by jobs.ExpireUnpaidBookings.
+const paymentHoldTTL = 15 * time.Minute
+
+// maxWebhookSize bounds the webhook bodies we read; processors send a few KB.
+const maxWebhookSize = 64 << 10
+
+// startPayment creates the processor intent for a pending booking and records
+// it against the booking.
+func (h *BookingHandler) startPayment(ctx context.Context, bookingID, eventID string, tier model.TicketTier, quantity int, expiresAt time.Time) (*gen.BookingPayment, error) {
+	amount := tier.PriceMinor * int64(quantity)
+	intent, err := h.provider.CreateIntent(ctx, payment.IntentRequest{
+		Amount:         amount,
+		Currency:       tier.Currency,
+		Description:    fmt.Sprintf("%d × %s ticket", quantity, tier.Name),
+		IdempotencyKey: bookingID,
+		Metadata:       map[string]string{"booking_id": bookingID, "event_id": eventID},
+	})
+	if err != nil {
+		log.Printf("Failed to create payment intent: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "payments are unavailable right now, try again later")
+	}
+
+	now := time.Now().UTC()
+	err = h.payments.CreatePayment(ctx, model.Payment{
+		PaymentID: uuid.New().String(),
+		BookingID: bookingID,
+		Provider:  h.provider.Name(),
+		IntentID:  intent.ID,
+		Amount:    amount,
+		Currency:  tier.Currency,
+		Status:    model.PaymentPending,
+		CreatedAt: now,
+	})
+	if err != nil {
+		log.Printf("Failed to create payment: %v", err)
+		if err := h.provider.CancelIntent(ctx, intent.ID); err != nil {
+			log.Printf("Failed to cancel payment intent %s: %v", intent.ID, err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to book event")
+	}
+
+	return &gen.BookingPayment{
+		Provider:     h.provider.Name(),
+		IntentId:     intent.ID,
+		ClientSecret: intent.ClientSecret,
+		AmountMinor:  amount,
+		Currency:     tier.Currency,
+		ExpiresAt:    expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// cancelPayment stops a pending booking's payment before the booking is
+// cancelled. If the processor will not cancel it the customer is probably
+// paying right now, and the webhook will settle the booking.
+func (h *BookingHandler) cancelPayment(ctx context.Context, bookingID string) error {
+	p, err := h.payments.GetPaymentByBooking(ctx, bookingID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		log.Printf("Failed to get payment: %v", err)
+		return status.Errorf(codes.Internal, "failed to cancel booking")
+	}
+	if p.Provider != h.provider.Name() {
+		return nil
+	}
+	if err := h.provider.CancelIntent(ctx, p.IntentID); err != nil {
+		log.Printf("Failed to cancel payment intent %s: %v", p.IntentID, err)
+		return status.Errorf(codes.FailedPrecondition, "the payment for this booking is being processed")
+	}
+	return nil
+}
+
+// cancelOpenPayments cancels with the processor the unsettled payments of the
+// event's or the user's bookings before those are cancelled or deleted, so
+// customers can no longer complete them. It fails with FailedPrecondition if
+// one may be going through right now.
+func cancelOpenPayments(ctx context.Context, payments intf.PaymentRepository, provider payment.Provider, eventID, userID string) error {
+	open, err := payments.ListOpenPayments(ctx, eventID, userID)
+	if err != nil {
+		log.Printf("Failed to list open payments: %v", err)
+		return status.Errorf(codes.Internal, "failed to cancel pending payments")
+	}
+	for _, p := range open {
+		// Intents of a processor we no longer use cannot be reached
+		if p.Provider != provider.Name() {
+			continue
+		}
+		if err := provider.CancelIntent(ctx, p.IntentID); err != nil {
+			log.Printf("Failed to cancel payment intent %s: %v", p.IntentID, err)
+			return status.Errorf(codes.FailedPrecondition, "a payment is being processed; try again in a few minutes")
+		}
+	}
+	return nil
+}
+
+// PaymentWebhook receives the processor's webhooks. It is plain HTTP rather
+// than a gateway route because the signature covers the raw body.
+type PaymentWebhook struct {
+	payments intf.PaymentRepository
+	provider payment.Provider
+}
+
+func NewPaymentWebhook(payments intf.PaymentRepository, provider payment.Provider) *PaymentWebhook {
+	return &PaymentWebhook{payments: payments, provider: provider}
+}
+
+// webhookStatus maps webhook events onto payment statuses.
+var webhookStatus = map[payment.EventType]string{
+	payment.EventSucceeded: model.PaymentSucceeded,
+	payment.EventFailed:    model.PaymentFailed,
+}
+
+func (h *PaymentWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookSize))
+	if err != nil {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	event, err := h.provider.ParseWebhook(body, r.Header)
+	if err != nil {
+		if !errors.Is(err, payment.ErrInvalidSignature) {
+			log.Printf("Failed to parse payment webhook: %v", err)
+		}
+		http.Error(w, "invalid webhook", http.StatusBadRequest)
+		return
+	}
+	paymentStatus, ok := webhookStatus[event.Type]
+	if !ok {
+		// Acknowledge events we do not act on so they are not redelivered
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	booking, applied, err := h.payments.ApplyPaymentEvent(r.Context(), h.provider.Name(), event.ID, event.IntentID, paymentStatus)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		log.Printf("Ignoring payment webhook %s for unknown intent %s", event.ID, event.IntentID)
+	case err != nil:
+		// Fail so the processor retries later
+		log.Printf("Failed to apply payment webhook %s: %v", event.ID, err)
+		http.Error(w, "failed to process webhook", http.StatusInternalServerError)
+		return
+	case !applied:
+		log.Printf("Ignoring duplicate payment webhook %s", event.ID)
+	case paymentStatus == model.PaymentSucceeded && booking.Status != model.BookingConfirmed:
+		// Paid after the hold expired or the booking was cancelled
+		log.Printf("Payment %s succeeded for %s booking %s; it will be refunded", event.IntentID, booking.Status, booking.BookingID)
+	case paymentStatus == model.PaymentSucceeded:
+		log.Printf("Booking %s paid and confirmed", booking.BookingID)
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/proto/gen"
+	"eventpass/utils"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AccessPolicy lists the access level required by every RPC. The auth
+// interceptor rejects methods that are not listed here.
+var AccessPolicy = map[string]auth.Access{
+	gen.UserService_RegisterUser_FullMethodName: auth.Public,
+	gen.UserService_UserLogin_FullMethodName:    auth.Public,
+	gen.UserService_AdminLogin_FullMethodName:   auth.Public,
+	gen.UserService_RefreshToken_FullMethodName: auth.Public,
+	gen.UserService_Logout_FullMethodName:       auth.Public,
+
+	gen.UserService_RequestPasswordReset_FullMethodName: auth.Public,
+	gen.UserService_ConfirmPasswordReset_FullMethodName: auth.Public,
+	gen.UserService_VerifyEmail_FullMethodName:          auth.Public,
+	gen.UserService_ResendVerification_FullMethodName:   auth.Public,
+	// Enrolment also works with the mfa_token of a login that requires it
+	gen.UserService_EnrollMFA_FullMethodName:  auth.Public,
+	gen.UserService_ConfirmMFA_FullMethodName: auth.Public,
+	gen.UserService_VerifyMFA_FullMethodName:  auth.Public,
+	gen.UserService_DisableMFA_FullMethodName: auth.Customer,
+
+	gen.UserService_ListOIDCProviders_FullMethodName: auth.Public,
+	gen.UserService_StartOIDCLogin_FullMethodName:    auth.Public,
+	gen.UserService_CompleteOIDCLogin_FullMethodName: auth.Public,
+
+	gen.UserService_GetMyProfile_FullMethodName:   auth.Customer,
+	gen.UserService_UpdateProfile_FullMethodName:  auth.Customer,
+	gen.UserService_ChangePassword_FullMethodName: auth.Customer,
+	gen.UserService_ChangeEmail_FullMethodName:    auth.Customer,
+	gen.UserService_DeleteAccount_FullMethodName:  auth.Customer,
+
+	// Organizers of the request's organization; the handler checks the role
+	gen.EventService_CreateEvent_FullMethodName:     auth.Customer,
+	gen.EventService_GetEventDetails_FullMethodName: auth.Public,
+	gen.EventService_ListEvents_FullMethodName:      auth.Public,
+	// Any user can be on an event's team; the handlers check their role there
+	gen.EventService_UpdateEvent_FullMethodName:        auth.Customer,
+	gen.EventService_DeleteEvent_FullMethodName:        auth.Customer,
+	gen.EventService_PublishEvent_FullMethodName:       auth.Customer,
+	gen.EventService_CancelEvent_FullMethodName:        auth.Customer,
+	gen.EventService_ListMyEvents_FullMethodName:       auth.Customer,
+	gen.EventService_ListEventAttendees_FullMethodName: auth.Customer,
+	gen.EventService_InviteEventMember_FullMethodName:  auth.Customer,
+	gen.EventService_AcceptEventInvite_FullMethodName:  auth.Customer,
+	gen.EventService_RemoveEventMember_FullMethodName:  auth.Customer,
+	gen.EventService_ListEventMembers_FullMethodName:   auth.Customer,
+
+	gen.BookingService_BookEvent_FullMethodName:       auth.Customer,
+	gen.BookingService_ListMyBookings_FullMethodName:  auth.Customer,
+	gen.BookingService_GetBooking_FullMethodName:      auth.Customer,
+	gen.BookingService_CancelBooking_FullMethodName:   auth.Customer,
+	gen.BookingService_CheckInBooking_FullMethodName:  auth.Customer,
+	gen.BookingService_CreateSeatHold_FullMethodName:  auth.Customer,
+	gen.BookingService_ExtendSeatHold_FullMethodName:  auth.Customer,
+	gen.BookingService_ReleaseSeatHold_FullMethodName: auth.Customer,
+
+	gen.AdminService_ListUsers_FullMethodName:         auth.Admin,
+	gen.AdminService_GetUser_FullMethodName:           auth.Admin,
+	gen.AdminService_SuspendUser_FullMethodName:       auth.Admin,
+	gen.AdminService_ReactivateUser_FullMethodName:    auth.Admin,
+	gen.AdminService_ResetUserPassword_FullMethodName: auth.Admin,
+	gen.AdminService_SetUserRole_FullMethodName:       auth.Admin,
+	gen.AdminService_ListLockouts_FullMethodName:      auth.Admin,
+	gen.AdminService_ClearLockout_FullMethodName:      auth.Admin,
+
+	gen.OrganizationService_CreateOrganization_FullMethodName: auth.Admin,
+	gen.OrganizationService_GetOrganization_FullMethodName:    auth.Public,
+	// The handlers check the caller's role in the organization
+	gen.OrganizationService_ListMyOrganizations_FullMethodName:      auth.Customer,
+	gen.OrganizationService_UpdateOrganization_FullMethodName:       auth.Customer,
+	gen.OrganizationService_AddOrganizationMember_FullMethodName:    auth.Customer,
+	gen.OrganizationService_RemoveOrganizationMember_FullMethodName: auth.Customer,
+	gen.OrganizationService_ListOrganizationMembers_FullMethodName:  auth.Customer,
+
+	gen.ApiKeyService_CreateApiKey_FullMethodName: auth.Organizer,
+	gen.ApiKeyService_ListApiKeys_FullMethodName:  auth.Organizer,
+	gen.ApiKeyService_RevokeApiKey_FullMethodName: auth.Organizer,
+}
+
+// AuthPolicy holds the operator's sign-in rules.
+type AuthPolicy struct {
+	// Users with an unverified email may still log in / book events
+	AllowUnverifiedLogin   bool
+	AllowUnverifiedBooking bool
+	// Admins must set up two-factor authentication before they get a session
+	RequireAdminMFA bool
+}
+
+// AuthPolicyFromEnv reads UNVERIFIED_LOGIN (default true), UNVERIFIED_BOOKING
+// (default false) and ADMIN_MFA_REQUIRED (default false).
+func AuthPolicyFromEnv() (AuthPolicy, error) {
+	var policy AuthPolicy
+	var err error
+	if policy.AllowUnverifiedLogin, err = boolEnv("UNVERIFIED_LOGIN", true); err != nil {
+		return AuthPolicy{}, err
+	}
+	if policy.AllowUnverifiedBooking, err = boolEnv("UNVERIFIED_BOOKING", false); err != nil {
+		return AuthPolicy{}, err
+	}
+	if policy.RequireAdminMFA, err = boolEnv("ADMIN_MFA_REQUIRED", false); err != nil {
+		return AuthPolicy{}, err
+	}
+	return policy, nil
+}
+
+// HoldPolicy holds the operator's seat hold limits.
+type HoldPolicy struct {
+	// How long a hold lasts after it is created or extended
+	TTL time.Duration
+	// Holds cannot be extended past this age
+	MaxAge time.Duration
+}
+
+// HoldPolicyFromEnv reads SEAT_HOLD_TTL (default 10m) and SEAT_HOLD_MAX_AGE
+// (default 30m).
+func HoldPolicyFromEnv() (HoldPolicy, error) {
+	var policy HoldPolicy
+	var err error
+	if policy.TTL, err = utils.DurationEnv("SEAT_HOLD_TTL", 10*time.Minute); err != nil {
+		return HoldPolicy{}, err
+	}
+	if policy.MaxAge, err = utils.DurationEnv("SEAT_HOLD_MAX_AGE", 30*time.Minute); err != nil {
+		return HoldPolicy{}, err
+	}
+	if policy.TTL <= 0 || policy.MaxAge < policy.TTL {
+		return HoldPolicy{}, fmt.Errorf("SEAT_HOLD_TTL must be positive and no longer than SEAT_HOLD_MAX_AGE")
+	}
+	return policy, nil
+}
+
+func boolEnv(key string, defaultValue bool) (bool, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// principal returns the authenticated caller attached by the auth interceptor.
+func principal(ctx context.Context) (*auth.Principal, error) {
+	p, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "missing access token")
+	}
+	return p, nil
+}
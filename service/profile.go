@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *UserHandler) GetMyProfile(ctx context.Context, req *gen.GetMyProfileRequest) (*gen.GetMyProfileResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gen.GetMyProfileResponse{
+		Profile: h.profileToProto(ctx, user),
+	}, nil
+}
+
+func (h *UserHandler) UpdateProfile(ctx context.Context, req *gen.UpdateProfileRequest) (*gen.UpdateProfileResponse, error) {
+	caller, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := profileUpdateFromProto(req.Profile, req.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.users.UpdateProfile(ctx, caller.UserID, update)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to update profile: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to update profile")
+	}
+
+	return &gen.UpdateProfileResponse{
+		Message: "Profile updated successfully",
+		Profile: h.profileToProto(ctx, user),
+	}, nil
+}
+
+func (h *UserHandler) ChangePassword(ctx context.Context, req *gen.ChangePasswordRequest) (*gen.ChangePasswordResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkCurrentPassword(ctx, user, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	// Hash password
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		log.Printf("Failed to hash password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to process password")
+	}
+	if err := h.users.UpdatePassword(ctx, user.UserID, string(hashedPassword)); err != nil {
+		log.Printf("Failed to update password: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to change password")
+	}
+
+	// Sign out everywhere, then hand the caller a fresh session
+	if err := h.sessions.RevokeSubjectRefreshTokens(ctx, user.UserID); err != nil {
+		log.Printf("Failed to revoke sessions: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to change password")
+	}
+	session, err := h.startSession(ctx, user.UserID, userRole(user))
+	if err != nil {
+		log.Printf("Failed to start session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
+	}
+
+	err = h.mail.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Your EventPass password was changed",
+		Body:    fmt.Sprintf("Hi %s,\n\nYour password was just changed and your other devices have been signed out.\n", user.FirstName),
+	})
+	if err != nil {
+		log.Printf("Failed to send password changed email: %v", err)
+	}
+
+	return &gen.ChangePasswordResponse{
+		Message:      "Password changed successfully",
+		AccessToken:  session.accessToken,
+		RefreshToken: session.refreshToken,
+		ExpiresIn:    session.expiresIn,
+	}, nil
+}
+
+func (h *UserHandler) ChangeEmail(ctx context.Context, req *gen.ChangeEmailRequest) (*gen.ChangeEmailResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkCurrentPassword(ctx, user, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(req.NewEmail, user.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "new_email is the current address")
+	}
+
+	err = h.users.ChangeEmail(ctx, user.UserID, req.NewEmail)
+	if err != nil {
+		// An address taken by another account comes back as AlreadyExists
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to change email: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to change email")
+	}
+
+	// Let the old address know in case the change was not theirs
+	err = h.mail.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Your EventPass email was changed",
+		Body:    fmt.Sprintf("Hi %s,\n\nThe email address on your account was changed to %s.\n", user.FirstName, req.NewEmail),
+	})
+	if err != nil {
+		log.Printf("Failed to send email changed notice: %v", err)
+	}
+
+	user.Email = req.NewEmail
+	if err := h.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+	}
+
+	return &gen.ChangeEmailResponse{
+		Message: "Email changed. Please check your inbox to verify the new address.",
+	}, nil
+}
+
+func (h *UserHandler) DeleteAccount(ctx context.Context, req *gen.DeleteAccountRequest) (*gen.DeleteAccountResponse, error) {
+	user, err := h.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkCurrentPassword(ctx, user, req.CurrentPassword); err != nil {
+		return nil, err
+	}
+
+	// Unpaid bookings are deleted with the account; make sure they stay unpaid
+	if err := cancelOpenPayments(ctx, h.payments, h.paymentProvider, "", user.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := h.users.DeleteUser(ctx, user.UserID); err != nil {
+		// Organizers must clear out their events first
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		log.Printf("Failed to delete user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to delete account")
+	}
+	log.Printf("User %s deleted their account", user.UserID)
+
+	return &gen.DeleteAccountResponse{
+		Message: "Account deleted",
+	}, nil
+}
+
+// currentUser loads the signed-in user. Admin accounts are managed
+// separately.
+func (h *UserHandler) currentUser(ctx context.Context) (model.User, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return model.User{}, err
+	}
+	if caller.IsAdmin() {
+		return model.User{}, status.Errorf(codes.FailedPrecondition, "only user accounts have a profile")
+	}
+
+	user, err := h.users.GetUser(ctx, caller.ID)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.User{}, status.Errorf(codes.NotFound, "user not found")
+		}
+		log.Printf("Failed to get user: %v", err)
+		return model.User{}, status.Errorf(codes.Internal, "failed to get user")
+	}
+	return user, nil
+}
+
+// checkCurrentPassword guards sensitive changes. Wrong guesses count
+// towards the same lockout as failed logins.
+func (h *UserHandler) checkCurrentPassword(ctx context.Context, user model.User, password string) error {
+	keys := userLoginKeys(ctx, "", user.Username)
+	if err := h.checkLockout(ctx, keys.account, keys.ip); err != nil {
+		return err
+	}
+	if !checkPassword(user.Password, password) {
+		h.recordFailure(ctx, keys)
+		return status.Errorf(codes.PermissionDenied, "current password is incorrect")
+	}
+	return nil
+}
+
+// profileUpdateFromProto picks the fields named in paths out of fields. With
+// no paths every non-empty field is used.
+func profileUpdateFromProto(fields *gen.ProfileUpdate, paths []string) (model.ProfileUpdate, error) {
+	var update model.ProfileUpdate
+	if fields == nil {
+		fields = &gen.ProfileUpdate{}
+	}
+
+	if len(paths) == 0 {
+		if fields.FirstName != "" {
+			paths = append(paths, "first_name")
+		}
+		if fields.LastName != "" {
+			paths = append(paths, "last_name")
+		}
+		if fields.Phone != "" {
+			paths = append(paths, "phone")
+		}
+	}
+	if len(paths) == 0 {
+		return update, status.Errorf(codes.InvalidArgument, "nothing to update")
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "first_name":
+			update.FirstName = &fields.FirstName
+		case "last_name":
+			update.LastName = &fields.LastName
+		case "phone":
+			update.Phone = &fields.Phone
+		default:
+			return update, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+	return update, nil
+}
+
+func (h *UserHandler) profileToProto(ctx context.Context, user model.User) *gen.UserProfile {
+	profile := &gen.UserProfile{
+		UserId:        user.UserID,
+		Username:      user.Username,
+		FirstName:     user.FirstName,
+		LastName:      user.LastName,
+		Email:         user.Email,
+		Phone:         user.Phone,
+		EmailVerified: user.EmailVerifiedAt != nil,
+		CreatedAt:     user.CreatedAt.Format(time.RFC3339),
+	}
+	if factor, err := h.mfa.GetMFAFactor(ctx, user.UserID); err == nil {
+		profile.MfaEnabled = factor.ConfirmedAt != nil
+	}
+	return profile
+}
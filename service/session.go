@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type session struct {
+	accessToken  string
+	refreshToken string
+	expiresIn    int64
+}
+
+// startSession issues an access token and the first refresh token of a new
+// token family for the subject.
+func (h *UserHandler) startSession(ctx context.Context, subjectID, role string) (session, error) {
+	return h.issueSession(ctx, "", model.RefreshToken{
+		FamilyID:  uuid.New().String(),
+		SubjectID: subjectID,
+		Role:      role,
+	})
+}
+
+// issueSession creates the next refresh token of base's family and a matching
+// access token. If previousID is set the previous token is rotated out.
+func (h *UserHandler) issueSession(ctx context.Context, previousID string, base model.RefreshToken) (session, error) {
+	refreshToken, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return session{}, err
+	}
+	next := model.RefreshToken{
+		TokenID:   uuid.New().String(),
+		FamilyID:  base.FamilyID,
+		SubjectID: base.SubjectID,
+		Role:      base.Role,
+		TokenHash: hash,
+		ExpiresAt: time.Now().UTC().Add(h.tokens.RefreshTTL),
+	}
+	if previousID == "" {
+		err = h.sessions.CreateRefreshToken(ctx, next)
+	} else {
+		err = h.sessions.RotateRefreshToken(ctx, previousID, next)
+	}
+	if err != nil {
+		return session{}, err
+	}
+
+	accessToken, expiresAt, err := h.tokens.IssueAccessToken(base.SubjectID, base.Role)
+	if err != nil {
+		return session{}, err
+	}
+	return session{
+		accessToken:  accessToken,
+		refreshToken: refreshToken,
+		expiresIn:    int64(time.Until(expiresAt).Seconds()),
+	}, nil
+}
+
+func (h *UserHandler) RefreshToken(ctx context.Context, req *gen.RefreshTokenRequest) (*gen.RefreshTokenResponse, error) {
+	token, err := h.sessions.GetRefreshTokenByHash(ctx, auth.HashToken(req.RefreshToken))
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Failed to get refresh token: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	// A revoked token being presented again means it was stolen or replayed;
+	// kill every token derived from the same login
+	if token.RevokedAt != nil {
+		log.Printf("Refresh token reuse detected for family %s", token.FamilyID)
+		if err := h.sessions.RevokeRefreshTokenFamily(ctx, token.FamilyID); err != nil {
+			log.Printf("Failed to revoke token family: %v", err)
+		}
+		return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+	}
+	if time.Now().UTC().After(token.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "refresh token expired")
+	}
+
+	s, err := h.issueSession(ctx, token.TokenID, token)
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			// Lost a race with another refresh of the same token
+			if err := h.sessions.RevokeRefreshTokenFamily(ctx, token.FamilyID); err != nil {
+				log.Printf("Failed to revoke token family: %v", err)
+			}
+			return nil, status.Errorf(codes.Unauthenticated, "invalid refresh token")
+		}
+		log.Printf("Failed to rotate refresh token: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to refresh session")
+	}
+
+	return &gen.RefreshTokenResponse{
+		AccessToken:  s.accessToken,
+		RefreshToken: s.refreshToken,
+		ExpiresIn:    s.expiresIn,
+	}, nil
+}
+
+func (h *UserHandler) Logout(ctx context.Context, req *gen.LogoutRequest) (*gen.LogoutResponse, error) {
+	token, err := h.sessions.GetRefreshTokenByHash(ctx, auth.HashToken(req.RefreshToken))
+	if err == nil {
+		err = h.sessions.RevokeRefreshTokenFamily(ctx, token.FamilyID)
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("Failed to revoke session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to log out")
+	}
+
+	// Unknown tokens are treated as already logged out
+	return &gen.LogoutResponse{
+		Message: "Logged out successfully",
+	}, nil
+}
@@ -2,7 +2,8 @@ package service
 
 import (
 	"context"
-	repository "eventpass/pgx"
+	"eventpass/auth"
+	"eventpass/model"
 	"eventpass/proto/gen"
 	"log"
 
@@ -12,33 +13,157 @@ import (
 )
 
 func (h *UserHandler) UserLogin(ctx context.Context, req *gen.LoginRequest) (*gen.LoginResponse, error) {
-	// Get user from database
-	user, err := repository.GetUserByUsername(ctx, req.Username)
-	if err != nil {
+	keys := userLoginKeys(ctx, req.UserId, req.Username)
+	if err := h.checkLockout(ctx, keys.account, keys.ip); err != nil {
+		return nil, err
+	}
+
+	// Get user from database by ID or username
+	var user model.User
+	var err error
+	switch {
+	case req.UserId != "":
+		user, err = h.users.GetUser(ctx, req.UserId)
+	case req.Username != "":
+		user, err = h.users.GetUserByUsername(ctx, req.Username)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "user_id or username is required")
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
 		log.Printf("Failed to get user: %v", err)
-		return nil, status.Errorf(codes.NotFound, "invalid credentials")
+		return nil, status.Errorf(codes.Internal, "failed to log in")
 	}
 
 	// Verify password
-	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password))
+	if !checkPassword(user.Password, req.Password) {
+		h.recordFailure(ctx, keys)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+	h.clearFailures(ctx, keys.account)
+
+	return h.completeLogin(ctx, user)
+}
+
+// completeLogin finishes the sign-in of a user whose identity is proven,
+// by password or by an external provider.
+func (h *UserHandler) completeLogin(ctx context.Context, user model.User) (*gen.LoginResponse, error) {
+	// Only checked once the credentials are known to be right
+	if user.SuspendedAt != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "account is suspended")
+	}
+	if user.EmailVerifiedAt == nil && !h.policy.AllowUnverifiedLogin {
+		return nil, status.Errorf(codes.FailedPrecondition, "email address is not verified")
+	}
+
+	// A second factor, if any, comes before the session
+	role := userRole(user)
+	challenge, err := h.loginChallenge(ctx, user.UserID, role)
+	if err != nil {
+		log.Printf("Failed to check MFA: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
+	}
+	if challenge != nil {
+		return &gen.LoginResponse{
+			Message:               "Two-factor authentication required",
+			UserId:                user.UserID,
+			EmailVerified:         user.EmailVerifiedAt != nil,
+			MfaRequired:           true,
+			MfaToken:              challenge.token,
+			MfaEnrollmentRequired: challenge.enroll,
+		}, nil
+	}
+
+	// Start a session for the user
+	session, err := h.startSession(ctx, user.UserID, role)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid credentials")
+		log.Printf("Failed to start session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
 	}
 
 	return &gen.LoginResponse{
-		Message: "Login successful",
+		Message:       "Login successful",
+		UserId:        user.UserID,
+		AccessToken:   session.accessToken,
+		RefreshToken:  session.refreshToken,
+		ExpiresIn:     session.expiresIn,
+		EmailVerified: user.EmailVerifiedAt != nil,
 	}, nil
 }
-// func (h *UserHandler) AdminLogin(ctx context.Context,req *gen.AdminLoginRequest) (*gen.AdminLoginResponse,error){
+func (h *UserHandler) AdminLogin(ctx context.Context, req *gen.AdminLoginRequest) (*gen.AdminLoginResponse, error) {
+	keys := adminLoginKeys(ctx, req.AdminId, req.Username)
+	if err := h.checkLockout(ctx, keys.account, keys.ip); err != nil {
+		return nil, err
+	}
 
-// 	//getting the admin details from db
-// 	err:=repository.
+	// Get admin from database by ID or username
+	var admin model.Admin
+	var err error
+	switch {
+	case req.AdminId != "":
+		admin, err = h.users.GetAdminById(ctx, req.AdminId)
+	case req.Username != "":
+		admin, err = h.users.GetAdminByUsername(ctx, req.Username)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "admin_id or username is required")
+	}
+	if err != nil && status.Code(err) != codes.NotFound {
+		log.Printf("Failed to get admin: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to log in")
+	}
+
+	// Verify password
+	if !checkPassword(admin.Password, req.Password) {
+		h.recordFailure(ctx, keys)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
+	}
+	h.clearFailures(ctx, keys.account)
 
+	// A second factor, if any, comes before the session
+	challenge, err := h.loginChallenge(ctx, admin.AdminID, auth.RoleAdmin)
+	if err != nil {
+		log.Printf("Failed to check MFA: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
+	}
+	if challenge != nil {
+		return &gen.AdminLoginResponse{
+			Message:               "Two-factor authentication required",
+			AdminId:               admin.AdminID,
+			MfaRequired:           true,
+			MfaToken:              challenge.token,
+			MfaEnrollmentRequired: challenge.enroll,
+		}, nil
+	}
 
+	// Start a session for the admin
+	session, err := h.startSession(ctx, admin.AdminID, auth.RoleAdmin)
+	if err != nil {
+		log.Printf("Failed to start session: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to start session")
+	}
 
+	return &gen.AdminLoginResponse{
+		Message:      "Admin login successful",
+		AdminId:      admin.AdminID,
+		AccessToken:  session.accessToken,
+		RefreshToken: session.refreshToken,
+		ExpiresIn:    session.expiresIn,
+	}, nil
+}
 
+// checkPassword compares against a dummy hash when the account was not
+// found, so both failures take the same time.
+func checkPassword(hash, password string) bool {
+	if hash == "" {
+		bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
 
-// 	return &gen.AdminLoginResponse{
-// 		Message: "Admin login successful",
-// 	}, nil
-// }
\ No newline at end of file
+// userRole is the role a user's tokens carry.
+func userRole(user model.User) string {
+	if user.Role == auth.RoleOrganizer {
+		return auth.RoleOrganizer
+	}
+	return auth.RoleCustomer
+}
@@ -2,8 +2,13 @@ package service
 
 import (
 	"context"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/oidc"
+	"eventpass/payment"
 	"eventpass/proto/gen"
-	"eventpass/pgx"
+	intf "eventpass/repository/intf"
 	"log"
 
 	"github.com/google/uuid"
@@ -14,10 +19,23 @@ import (
 
 type UserHandler struct {
 	gen.UnimplementedUserServiceServer
+	users      intf.UserRepository
+	sessions   intf.SessionRepository
+	userTokens intf.UserTokenRepository
+	mfa        intf.MFARepository
+	throttles  intf.LoginThrottleRepository
+	identities intf.OIDCRepository
+	providers  map[string]*oidc.Provider
+	payments   intf.PaymentRepository
+	// The card processor, not to be confused with the identity providers
+	paymentProvider payment.Provider
+	tokens          *auth.TokenManager
+	mail            mailer.Mailer
+	policy          AuthPolicy
 }
 
-func NewUserHandler() *UserHandler {
-	return &UserHandler{}
+func NewUserHandler(users intf.UserRepository, sessions intf.SessionRepository, userTokens intf.UserTokenRepository, mfa intf.MFARepository, throttles intf.LoginThrottleRepository, identities intf.OIDCRepository, providers map[string]*oidc.Provider, payments intf.PaymentRepository, paymentProvider payment.Provider, tokens *auth.TokenManager, mail mailer.Mailer, policy AuthPolicy) *UserHandler {
+	return &UserHandler{users: users, sessions: sessions, userTokens: userTokens, mfa: mfa, throttles: throttles, identities: identities, providers: providers, payments: payments, paymentProvider: paymentProvider, tokens: tokens, mail: mail, policy: policy}
 }
 
 func (h *UserHandler) RegisterUser(ctx context.Context, req *gen.RegisterRequest) (*gen.RegisterResponse, error) {
@@ -32,15 +50,24 @@ func (h *UserHandler) RegisterUser(ctx context.Context, req *gen.RegisterRequest
 	}
 
 	// Create user in database
-	err = repository.CreateUser(ctx, userID, req.FirstName, req.LastName, req.Username, string(hashedPassword), req.Phone, req.Email)
+	err = h.users.CreateUser(ctx, userID, req.Email, string(hashedPassword), req.FirstName, req.LastName, req.Username, req.Phone)
 	if err != nil {
+		// Duplicate username or email comes back as AlreadyExists
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		log.Printf("Failed to create user: %v", err)
 		return nil, status.Errorf(codes.Internal, "failed to create user")
 	}
 
+	// The account exists either way; the user can ask for another link
+	user := model.User{UserID: userID, Email: req.Email, FirstName: req.FirstName}
+	if err := h.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+	}
+
 	return &gen.RegisterResponse{
 		Id:      userID,
-		Message: "User registered successfully",
+		Message: "User registered successfully, check your email to verify your address",
 	}, nil
 }
-
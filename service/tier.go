@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Most tiers a single event may offer
+const maxTicketTiers = 20
+
+// ticketTiersFromProto turns the tiers of a CreateEvent request into new
+// tiers. The request was validated, so the sale window parses.
+func ticketTiersFromProto(tiers []*gen.TicketTier) []model.TicketTier {
+	var result []model.TicketTier
+	for i, t := range tiers {
+		tier := model.TicketTier{
+			TierID:      uuid.New().String(),
+			Name:        t.Name,
+			PriceMinor:  t.PriceMinor,
+			Currency:    t.Currency,
+			Capacity:    int(t.Capacity),
+			MaxPerOrder: int(t.MaxPerOrder),
+			Position:    i,
+		}
+		// Sale windows are checked against time.Now().UTC() and sales_start and
+		// sales_end have no zone, so keep them in UTC
+		if t.SalesStart != "" {
+			start, _ := time.Parse(time.RFC3339, t.SalesStart)
+			start = start.UTC()
+			tier.SalesStart = &start
+		}
+		if t.SalesEnd != "" {
+			end, _ := time.Parse(time.RFC3339, t.SalesEnd)
+			end = end.UTC()
+			tier.SalesEnd = &end
+		}
+		result = append(result, tier)
+	}
+	return result
+}
+
+// loadTiers returns the ticket tiers of the events keyed by event ID.
+func (h *EventHandler) loadTiers(ctx context.Context, orgID string, events ...model.Event) (map[string][]model.TicketTier, error) {
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, e.Event_ID)
+	}
+	tiers, err := h.events.ListTicketTiers(ctx, orgID, ids)
+	if err != nil {
+		log.Printf("Failed to list ticket tiers: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get ticket tiers")
+	}
+	byEvent := make(map[string][]model.TicketTier, len(events))
+	for _, t := range tiers {
+		byEvent[t.EventID] = append(byEvent[t.EventID], t)
+	}
+	return byEvent, nil
+}
+
+// chooseTier picks the tier a booking of quantity tickets is for and checks
+// it is on sale and within its per-order limit. Capacity is left to the
+// repository, which reserves the tickets atomically. Events without tiers
+// are booked without one.
+func chooseTier(tiers []model.TicketTier, tierID string, quantity int, now time.Time) (model.TicketTier, error) {
+	if len(tiers) == 0 {
+		if tierID != "" {
+			return model.TicketTier{}, status.Errorf(codes.NotFound, "ticket tier not found")
+		}
+		return model.TicketTier{}, nil
+	}
+	if tierID == "" {
+		return model.TicketTier{}, status.Errorf(codes.InvalidArgument, "tier_id is required: choose one of the event's ticket tiers")
+	}
+
+	for _, tier := range tiers {
+		if tier.TierID != tierID {
+			continue
+		}
+		switch {
+		case tier.SalesStart != nil && now.Before(*tier.SalesStart):
+			return model.TicketTier{}, status.Errorf(codes.FailedPrecondition, "%s tickets go on sale at %s", tier.Name, tier.SalesStart.Format(time.RFC3339))
+		case !tier.OnSale(now):
+			return model.TicketTier{}, status.Errorf(codes.FailedPrecondition, "%s tickets are no longer on sale", tier.Name)
+		case tier.MaxPerOrder > 0 && quantity > tier.MaxPerOrder:
+			return model.TicketTier{}, status.Errorf(codes.InvalidArgument, "at most %d %s tickets can be booked at once", tier.MaxPerOrder, tier.Name)
+		}
+		return tier, nil
+	}
+	return model.TicketTier{}, status.Errorf(codes.NotFound, "ticket tier not found")
+}
+
+func tierToProto(tier model.TicketTier) *gen.TicketTier {
+	resp := &gen.TicketTier{
+		TierId:      tier.TierID,
+		Name:        tier.Name,
+		PriceMinor:  tier.PriceMinor,
+		Currency:    tier.Currency,
+		Capacity:    int32(tier.Capacity),
+		Available:   int32(max(tier.Capacity-tier.Sold, 0)),
+		MaxPerOrder: int32(tier.MaxPerOrder),
+	}
+	if tier.SalesStart != nil {
+		resp.SalesStart = tier.SalesStart.Format(time.RFC3339)
+	}
+	if tier.SalesEnd != nil {
+		resp.SalesEnd = tier.SalesEnd.Format(time.RFC3339)
+	}
+	return resp
+}
+
+func tiersToProto(tiers []model.TicketTier) []*gen.TicketTier {
+	resp := make([]*gen.TicketTier, 0, len(tiers))
+	for _, t := range tiers {
+		resp = append(resp, tierToProto(t))
+	}
+	return resp
+}
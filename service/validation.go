@@ -0,0 +1,548 @@
+package service
+
+import (
+	"eventpass/auth"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"eventpass/validate"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	minPasswordLength = 8
+	// bcrypt ignores everything past 72 bytes
+	maxPasswordLength = 72
+)
+
+var (
+	// Slugs name organizations in URLs and the X-Organization header
+	slugPattern  = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	// ISO 4217 currency codes
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// RequestRules lists the input checks for every RPC that takes user input.
+// The validation interceptor runs them before the handler, so handlers can
+// assume well-formed requests.
+var RequestRules = map[string]validate.Rule{
+	gen.UserService_RegisterUser_FullMethodName: validate.For(func(req *gen.RegisterRequest, v *validate.Violations) {
+		if validate.Required(v, "email", req.Email) && validate.MaxLength(v, "email", req.Email, 100) {
+			validate.Email(v, "email", req.Email)
+		}
+		if validate.Required(v, "password", req.Password) {
+			validate.Length(v, "password", req.Password, minPasswordLength, maxPasswordLength)
+		}
+		if validate.Required(v, "first_name", req.FirstName) {
+			validate.MaxLength(v, "first_name", req.FirstName, 100)
+		}
+		if validate.Required(v, "last_name", req.LastName) {
+			validate.MaxLength(v, "last_name", req.LastName, 100)
+		}
+		if validate.Required(v, "username", req.Username) && validate.Length(v, "username", req.Username, 3, 50) {
+			validate.Username(v, "username", req.Username)
+		}
+		if validate.Required(v, "phone", req.Phone) {
+			validate.Phone(v, "phone", req.Phone)
+		}
+	}),
+	gen.UserService_UserLogin_FullMethodName: validate.For(func(req *gen.LoginRequest, v *validate.Violations) {
+		if req.UserId == "" && req.Username == "" {
+			v.Add("username", "username or user_id is required")
+		}
+		validate.Required(v, "password", req.Password)
+	}),
+	gen.UserService_AdminLogin_FullMethodName: validate.For(func(req *gen.AdminLoginRequest, v *validate.Violations) {
+		if req.AdminId == "" && req.Username == "" {
+			v.Add("username", "username or admin_id is required")
+		}
+		validate.Required(v, "password", req.Password)
+	}),
+	gen.UserService_RefreshToken_FullMethodName: validate.For(func(req *gen.RefreshTokenRequest, v *validate.Violations) {
+		validate.Required(v, "refresh_token", req.RefreshToken)
+	}),
+	gen.UserService_Logout_FullMethodName: validate.For(func(req *gen.LogoutRequest, v *validate.Violations) {
+		validate.Required(v, "refresh_token", req.RefreshToken)
+	}),
+	gen.UserService_RequestPasswordReset_FullMethodName: validate.For(func(req *gen.RequestPasswordResetRequest, v *validate.Violations) {
+		if validate.Required(v, "email", req.Email) {
+			validate.Email(v, "email", req.Email)
+		}
+	}),
+	gen.UserService_ConfirmPasswordReset_FullMethodName: validate.For(func(req *gen.ConfirmPasswordResetRequest, v *validate.Violations) {
+		validate.Required(v, "token", req.Token)
+		if validate.Required(v, "new_password", req.NewPassword) {
+			validate.Length(v, "new_password", req.NewPassword, minPasswordLength, maxPasswordLength)
+		}
+	}),
+
+	gen.UserService_VerifyEmail_FullMethodName: validate.For(func(req *gen.VerifyEmailRequest, v *validate.Violations) {
+		validate.Required(v, "token", req.Token)
+	}),
+	gen.UserService_ResendVerification_FullMethodName: validate.For(func(req *gen.ResendVerificationRequest, v *validate.Violations) {
+		if validate.Required(v, "email", req.Email) {
+			validate.Email(v, "email", req.Email)
+		}
+	}),
+	gen.UserService_ConfirmMFA_FullMethodName: validate.For(func(req *gen.ConfirmMFARequest, v *validate.Violations) {
+		validate.Required(v, "code", req.Code)
+	}),
+	gen.UserService_VerifyMFA_FullMethodName: validate.For(func(req *gen.VerifyMFARequest, v *validate.Violations) {
+		validate.Required(v, "mfa_token", req.MfaToken)
+		if (req.Code == "") == (req.RecoveryCode == "") {
+			v.Add("code", "exactly one of code or recovery_code is required")
+		}
+	}),
+	gen.UserService_DisableMFA_FullMethodName: validate.For(func(req *gen.DisableMFARequest, v *validate.Violations) {
+		validate.Required(v, "code", req.Code)
+	}),
+
+	gen.UserService_StartOIDCLogin_FullMethodName: validate.For(func(req *gen.StartOIDCLoginRequest, v *validate.Violations) {
+		validate.Required(v, "provider", req.Provider)
+	}),
+	gen.UserService_CompleteOIDCLogin_FullMethodName: validate.For(func(req *gen.CompleteOIDCLoginRequest, v *validate.Violations) {
+		validate.Required(v, "provider", req.Provider)
+		validate.Required(v, "code", req.Code)
+		validate.Required(v, "state", req.State)
+	}),
+
+	gen.UserService_UpdateProfile_FullMethodName: validate.For(func(req *gen.UpdateProfileRequest, v *validate.Violations) {
+		if req.Profile == nil {
+			v.Add("profile", "is required")
+			return
+		}
+		// Only the fields being changed are checked; empty means unchanged
+		p := req.Profile
+		for _, path := range req.UpdateMask.GetPaths() {
+			switch path {
+			case "first_name", "last_name", "phone":
+				if profileUpdateField(p, path) == "" {
+					v.Add("profile."+path, "cannot be cleared")
+				}
+			}
+		}
+		validate.MaxLength(v, "profile.first_name", p.FirstName, 100)
+		validate.MaxLength(v, "profile.last_name", p.LastName, 100)
+		if p.Phone != "" {
+			validate.Phone(v, "profile.phone", p.Phone)
+		}
+	}),
+	gen.UserService_ChangePassword_FullMethodName: validate.For(func(req *gen.ChangePasswordRequest, v *validate.Violations) {
+		validate.Required(v, "current_password", req.CurrentPassword)
+		if validate.Required(v, "new_password", req.NewPassword) {
+			validate.Length(v, "new_password", req.NewPassword, minPasswordLength, maxPasswordLength)
+		}
+	}),
+	gen.UserService_ChangeEmail_FullMethodName: validate.For(func(req *gen.ChangeEmailRequest, v *validate.Violations) {
+		if validate.Required(v, "new_email", req.NewEmail) && validate.MaxLength(v, "new_email", req.NewEmail, 100) {
+			validate.Email(v, "new_email", req.NewEmail)
+		}
+		validate.Required(v, "current_password", req.CurrentPassword)
+	}),
+	gen.UserService_DeleteAccount_FullMethodName: validate.For(func(req *gen.DeleteAccountRequest, v *validate.Violations) {
+		validate.Required(v, "current_password", req.CurrentPassword)
+	}),
+
+	gen.EventService_CreateEvent_FullMethodName: validate.For(func(req *gen.CreateEventRequest, v *validate.Violations) {
+		if validate.Required(v, "event_title", req.EventTitle) {
+			validate.MaxLength(v, "event_title", req.EventTitle, 200)
+		}
+		if validate.Required(v, "event_location", req.EventLocation) {
+			validate.MaxLength(v, "event_location", req.EventLocation, 255)
+		}
+		validateSchedule(v, "", req.EventDate, req.EventStartTime, req.EventEndTime, true)
+		validate.NonNegative(v, "total_slots", req.TotalSlots)
+		validateTicketTiers(v, req.TicketTiers, req.TotalSlots)
+	}),
+	gen.EventService_GetEventDetails_FullMethodName: validate.For(func(req *gen.GetEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+	}),
+	gen.EventService_ListEvents_FullMethodName: validate.For(func(req *gen.ListEventsRequest, v *validate.Violations) {
+		if req.StartDate != "" {
+			validate.Date(v, "start_date", req.StartDate)
+		}
+		if req.EndDate != "" {
+			validate.Date(v, "end_date", req.EndDate)
+		}
+		validate.OneOf(v, "sort_by", req.SortBy, "date", "created_at", "title")
+		validate.OneOf(v, "sort_order", req.SortOrder, "asc", "desc")
+		validate.OneOf(v, "status", req.Status, model.EventDraft, model.EventPublished, model.EventCancelled, model.EventCompleted)
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.EventService_UpdateEvent_FullMethodName: validate.For(func(req *gen.UpdateEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		if req.Version <= 0 {
+			v.Add("version", "is required")
+		}
+		if req.Event == nil {
+			v.Add("event", "is required")
+			return
+		}
+		// Only the fields being changed are checked: those in update_mask, or
+		// without one every field, where empty means unchanged
+		e, paths := req.Event, req.UpdateMask.GetPaths()
+		changed := func(path string) bool {
+			return len(paths) == 0 || slices.Contains(paths, path)
+		}
+		for _, path := range paths {
+			switch path {
+			case "event_title", "event_location", "event_date", "event_start_time", "event_end_time":
+				if !v.Has("event."+path) && eventUpdateField(e, path) == "" {
+					v.Add("event."+path, "cannot be cleared")
+				}
+			}
+		}
+		if changed("event_title") {
+			validate.MaxLength(v, "event.event_title", e.EventTitle, 200)
+		}
+		if changed("event_location") {
+			validate.MaxLength(v, "event.event_location", e.EventLocation, 255)
+		}
+		schedule := func(path string) string {
+			if !changed(path) {
+				return ""
+			}
+			return eventUpdateField(e, path)
+		}
+		validateSchedule(v, "event.", schedule("event_date"), schedule("event_start_time"), schedule("event_end_time"), false)
+		if changed("total_slots") {
+			validate.NonNegative(v, "event.total_slots", e.TotalSlots)
+		}
+	}),
+	gen.EventService_DeleteEvent_FullMethodName: validate.For(func(req *gen.DeleteEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+	}),
+	gen.EventService_PublishEvent_FullMethodName: validate.For(func(req *gen.PublishEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+	}),
+	gen.EventService_CancelEvent_FullMethodName: validate.For(func(req *gen.CancelEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		validate.Required(v, "reason", req.Reason)
+	}),
+	gen.EventService_ListMyEvents_FullMethodName: validate.For(func(req *gen.ListMyEventsRequest, v *validate.Violations) {
+		validate.OneOf(v, "status", req.Status, model.EventDraft, model.EventPublished, model.EventCancelled, model.EventCompleted)
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.EventService_ListEventAttendees_FullMethodName: validate.For(func(req *gen.ListEventAttendeesRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		validate.OneOf(v, "status", req.Status, model.BookingConfirmed, model.BookingPendingPayment, model.BookingCancelled, model.BookingExpired)
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.EventService_InviteEventMember_FullMethodName: validate.For(func(req *gen.InviteEventMemberRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		switch {
+		case req.Username == "" && req.Email == "":
+			v.Add("username", "username or email is required")
+		case req.Username != "" && req.Email != "":
+			v.Add("email", "give either username or email, not both")
+		case req.Email != "":
+			validate.Email(v, "email", req.Email)
+		}
+		if validate.Required(v, "role", req.Role) {
+			validate.OneOf(v, "role", req.Role, model.MemberOwner, model.MemberCoOrganizer, model.MemberCheckInStaff, model.MemberViewer)
+		}
+	}),
+	gen.EventService_AcceptEventInvite_FullMethodName: validate.For(func(req *gen.AcceptEventInviteRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+	}),
+	gen.EventService_RemoveEventMember_FullMethodName: validate.For(func(req *gen.RemoveEventMemberRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		validate.Required(v, "user_id", req.UserId)
+	}),
+	gen.EventService_ListEventMembers_FullMethodName: validate.For(func(req *gen.ListEventMembersRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+	}),
+
+	gen.BookingService_BookEvent_FullMethodName: validate.For(func(req *gen.BookEventRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		validate.NonNegative(v, "quantity", req.Quantity)
+	}),
+	gen.BookingService_ListMyBookings_FullMethodName: validate.For(func(req *gen.ListMyBookingsRequest, v *validate.Violations) {
+		validate.OneOf(v, "status", req.Status, model.BookingConfirmed, model.BookingPendingPayment, model.BookingCancelled, model.BookingExpired)
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.BookingService_GetBooking_FullMethodName: validate.For(func(req *gen.GetBookingRequest, v *validate.Violations) {
+		validate.Required(v, "booking_id", req.BookingId)
+	}),
+	gen.BookingService_CancelBooking_FullMethodName: validate.For(func(req *gen.CancelBookingRequest, v *validate.Violations) {
+		validate.Required(v, "booking_id", req.BookingId)
+	}),
+	gen.BookingService_CheckInBooking_FullMethodName: validate.For(func(req *gen.CheckInBookingRequest, v *validate.Violations) {
+		validate.Required(v, "booking_id", req.BookingId)
+	}),
+	gen.BookingService_CreateSeatHold_FullMethodName: validate.For(func(req *gen.CreateSeatHoldRequest, v *validate.Violations) {
+		validate.Required(v, "event_id", req.EventId)
+		validate.NonNegative(v, "quantity", req.Quantity)
+	}),
+	gen.BookingService_ExtendSeatHold_FullMethodName: validate.For(func(req *gen.ExtendSeatHoldRequest, v *validate.Violations) {
+		validate.Required(v, "hold_id", req.HoldId)
+	}),
+	gen.BookingService_ReleaseSeatHold_FullMethodName: validate.For(func(req *gen.ReleaseSeatHoldRequest, v *validate.Violations) {
+		validate.Required(v, "hold_id", req.HoldId)
+	}),
+
+	gen.AdminService_ListUsers_FullMethodName: validate.For(func(req *gen.ListUsersRequest, v *validate.Violations) {
+		validate.OneOf(v, "role", req.Role, auth.RoleCustomer, auth.RoleOrganizer)
+		var from, to time.Time
+		var hasFrom, hasTo bool
+		if req.CreatedFrom != "" {
+			from, hasFrom = validate.Date(v, "created_from", req.CreatedFrom)
+		}
+		if req.CreatedTo != "" {
+			to, hasTo = validate.Date(v, "created_to", req.CreatedTo)
+		}
+		if hasFrom && hasTo && to.Before(from) {
+			v.Add("created_to", "must not be before created_from")
+		}
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.AdminService_GetUser_FullMethodName: validate.For(func(req *gen.GetUserRequest, v *validate.Violations) {
+		validate.Required(v, "user_id", req.UserId)
+	}),
+	gen.AdminService_SuspendUser_FullMethodName: validate.For(func(req *gen.SuspendUserRequest, v *validate.Violations) {
+		validate.Required(v, "user_id", req.UserId)
+		if validate.Required(v, "reason", req.Reason) {
+			validate.MaxLength(v, "reason", req.Reason, 500)
+		}
+	}),
+	gen.AdminService_ReactivateUser_FullMethodName: validate.For(func(req *gen.ReactivateUserRequest, v *validate.Violations) {
+		validate.Required(v, "user_id", req.UserId)
+	}),
+	gen.AdminService_ResetUserPassword_FullMethodName: validate.For(func(req *gen.ResetUserPasswordRequest, v *validate.Violations) {
+		validate.Required(v, "user_id", req.UserId)
+	}),
+	gen.AdminService_SetUserRole_FullMethodName: validate.For(func(req *gen.SetUserRoleRequest, v *validate.Violations) {
+		validate.Required(v, "user_id", req.UserId)
+		if validate.Required(v, "role", req.Role) {
+			validate.OneOf(v, "role", req.Role, auth.RoleCustomer, auth.RoleOrganizer)
+		}
+	}),
+	gen.AdminService_ListLockouts_FullMethodName: validate.For(func(req *gen.ListLockoutsRequest, v *validate.Violations) {
+		validate.NonNegative(v, "page", req.Page)
+		validate.NonNegative(v, "limit", req.Limit)
+	}),
+	gen.AdminService_ClearLockout_FullMethodName: validate.For(func(req *gen.ClearLockoutRequest, v *validate.Violations) {
+		validate.Required(v, "key", req.Key)
+	}),
+
+	gen.OrganizationService_CreateOrganization_FullMethodName: validate.For(func(req *gen.CreateOrganizationRequest, v *validate.Violations) {
+		if validate.Required(v, "slug", req.Slug) && validate.Length(v, "slug", req.Slug, 3, 30) && !slugPattern.MatchString(req.Slug) {
+			v.Add("slug", "must be lowercase letters and digits separated by single dashes")
+		}
+		if validate.Required(v, "name", req.Name) {
+			validate.MaxLength(v, "name", req.Name, 100)
+		}
+		validateBranding(v, "", req.LogoUrl, req.PrimaryColor, req.SupportEmail)
+		validate.NonNegative(v, "max_event_slots", req.MaxEventSlots)
+		if req.OwnerUsername != "" {
+			validate.Username(v, "owner_username", req.OwnerUsername)
+		}
+	}),
+	gen.OrganizationService_GetOrganization_FullMethodName: validate.For(func(req *gen.GetOrganizationRequest, v *validate.Violations) {
+		validate.Required(v, "org_id", req.OrgId)
+	}),
+	gen.OrganizationService_UpdateOrganization_FullMethodName: validate.For(func(req *gen.UpdateOrganizationRequest, v *validate.Violations) {
+		validate.Required(v, "org_id", req.OrgId)
+		if req.Organization == nil {
+			v.Add("organization", "is required")
+			return
+		}
+		o := req.Organization
+		for _, path := range req.UpdateMask.GetPaths() {
+			if path == "name" && o.Name == "" {
+				v.Add("organization.name", "cannot be cleared")
+			}
+		}
+		validate.MaxLength(v, "organization.name", o.Name, 100)
+		validateBranding(v, "organization.", o.LogoUrl, o.PrimaryColor, o.SupportEmail)
+		validate.NonNegative(v, "organization.max_event_slots", o.MaxEventSlots)
+	}),
+	gen.OrganizationService_AddOrganizationMember_FullMethodName: validate.For(func(req *gen.AddOrganizationMemberRequest, v *validate.Violations) {
+		validate.Required(v, "org_id", req.OrgId)
+		switch {
+		case req.Username == "" && req.Email == "":
+			v.Add("username", "username or email is required")
+		case req.Username != "" && req.Email != "":
+			v.Add("email", "give either username or email, not both")
+		case req.Email != "":
+			validate.Email(v, "email", req.Email)
+		}
+		if validate.Required(v, "role", req.Role) {
+			validate.OneOf(v, "role", req.Role, model.OrgRoleAdmin, model.OrgRoleOrganizer, model.OrgRoleMember)
+		}
+	}),
+	gen.OrganizationService_RemoveOrganizationMember_FullMethodName: validate.For(func(req *gen.RemoveOrganizationMemberRequest, v *validate.Violations) {
+		validate.Required(v, "org_id", req.OrgId)
+		validate.Required(v, "user_id", req.UserId)
+	}),
+	gen.OrganizationService_ListOrganizationMembers_FullMethodName: validate.For(func(req *gen.ListOrganizationMembersRequest, v *validate.Violations) {
+		validate.Required(v, "org_id", req.OrgId)
+	}),
+
+	gen.ApiKeyService_CreateApiKey_FullMethodName: validate.For(func(req *gen.CreateApiKeyRequest, v *validate.Violations) {
+		if validate.Required(v, "name", req.Name) {
+			validate.MaxLength(v, "name", req.Name, 100)
+		}
+		if len(req.Scopes) == 0 {
+			v.Add("scopes", "at least one scope is required")
+		}
+		for i, scope := range req.Scopes {
+			if _, ok := apiKeyScopeMethods(scope); !ok {
+				v.Add(fmt.Sprintf("scopes[%d]", i), fmt.Sprintf("unknown scope %q", scope))
+			}
+		}
+	}),
+	gen.ApiKeyService_RevokeApiKey_FullMethodName: validate.For(func(req *gen.RevokeApiKeyRequest, v *validate.Violations) {
+		validate.Required(v, "key_id", req.KeyId)
+	}),
+}
+
+// validateBranding checks an organization's optional branding fields.
+func validateBranding(v *validate.Violations, prefix, logoURL, color, email string) {
+	if logoURL != "" && validate.MaxLength(v, prefix+"logo_url", logoURL, 500) {
+		if u, err := url.Parse(logoURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			v.Add(prefix+"logo_url", "must be an http or https URL")
+		}
+	}
+	if color != "" && !colorPattern.MatchString(color) {
+		v.Add(prefix+"primary_color", "must be a hex color such as #1a73e8")
+	}
+	if email != "" && validate.MaxLength(v, prefix+"support_email", email, 100) {
+		validate.Email(v, prefix+"support_email", email)
+	}
+}
+
+// validateTicketTiers checks the tiers of a new event: each needs a unique
+// name, a capacity within the event's, and a currency when it is not free.
+// Paid tiers of one event must share a currency.
+func validateTicketTiers(v *validate.Violations, tiers []*gen.TicketTier, totalSlots int32) {
+	if len(tiers) > maxTicketTiers {
+		v.Add("ticket_tiers", fmt.Sprintf("at most %d tiers are allowed", maxTicketTiers))
+		return
+	}
+	names := map[string]bool{}
+	currency := ""
+	for i, tier := range tiers {
+		prefix := fmt.Sprintf("ticket_tiers[%d].", i)
+		if validate.Required(v, prefix+"name", tier.Name) && validate.MaxLength(v, prefix+"name", tier.Name, 100) {
+			if names[strings.ToLower(tier.Name)] {
+				v.Add(prefix+"name", "is used by another tier")
+			}
+			names[strings.ToLower(tier.Name)] = true
+		}
+		if tier.PriceMinor < 0 {
+			v.Add(prefix+"price_minor", "must not be negative")
+		}
+		switch {
+		case tier.Currency != "" && !currencyPattern.MatchString(tier.Currency):
+			v.Add(prefix+"currency", "must be an ISO 4217 code such as USD")
+		case tier.Currency == "" && tier.PriceMinor > 0:
+			v.Add(prefix+"currency", "is required for paid tiers")
+		case tier.Currency != "" && currency != "" && tier.Currency != currency:
+			v.Add(prefix+"currency", "must match the other tiers")
+		case tier.Currency != "":
+			currency = tier.Currency
+		}
+		if tier.Capacity <= 0 {
+			v.Add(prefix+"capacity", "must be positive")
+		} else if tier.Capacity > totalSlots {
+			v.Add(prefix+"capacity", "cannot exceed total_slots")
+		}
+		validate.NonNegative(v, prefix+"max_per_order", tier.MaxPerOrder)
+
+		var start, end time.Time
+		var err error
+		if tier.SalesStart != "" {
+			if start, err = time.Parse(time.RFC3339, tier.SalesStart); err != nil {
+				v.Add(prefix+"sales_start", "must be an RFC 3339 timestamp")
+			}
+		}
+		if tier.SalesEnd != "" {
+			if end, err = time.Parse(time.RFC3339, tier.SalesEnd); err != nil {
+				v.Add(prefix+"sales_end", "must be an RFC 3339 timestamp")
+			}
+		}
+		if !start.IsZero() && !end.IsZero() && !end.After(start) {
+			v.Add(prefix+"sales_end", "must be after sales_start")
+		}
+	}
+}
+
+// validateSchedule checks an event's date and times: well-formed, the end
+// after the start, and not in the past. When required is false, empty fields
+// are left unchanged by the caller and skipped.
+func validateSchedule(v *validate.Violations, prefix, date, start, end string, required bool) {
+	var (
+		day                      time.Time
+		startAt, endAt           time.Duration
+		hasDay, hasStart, hasEnd bool
+	)
+	if date != "" {
+		day, hasDay = validate.Date(v, prefix+"event_date", date)
+	} else if required {
+		v.Add(prefix+"event_date", "is required")
+	}
+	if start != "" {
+		startAt, hasStart = validate.Time(v, prefix+"event_start_time", start)
+	} else if required {
+		v.Add(prefix+"event_start_time", "is required")
+	}
+	if end != "" {
+		endAt, hasEnd = validate.Time(v, prefix+"event_end_time", end)
+	} else if required {
+		v.Add(prefix+"event_end_time", "is required")
+	}
+
+	if hasStart && hasEnd && endAt <= startAt {
+		v.Add(prefix+"event_end_time", "must be after event_start_time")
+	}
+
+	// Event dates are wall-clock times in the server's zone
+	if hasDay {
+		now := time.Now()
+		startsAt := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.Local).Add(startAt)
+		if hasStart && !startsAt.After(now) {
+			v.Add(prefix+"event_date", "must be in the future")
+		} else if !hasStart && startsAt.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)) {
+			v.Add(prefix+"event_date", "must not be in the past")
+		}
+	}
+}
+
+func profileUpdateField(p *gen.ProfileUpdate, path string) string {
+	switch path {
+	case "first_name":
+		return p.FirstName
+	case "last_name":
+		return p.LastName
+	case "phone":
+		return p.Phone
+	}
+	return ""
+}
+
+func eventUpdateField(e *gen.EventUpdate, path string) string {
+	switch path {
+	case "event_title":
+		return e.EventTitle
+	case "event_location":
+		return e.EventLocation
+	case "event_date":
+		return e.EventDate
+	case "event_start_time":
+		return e.EventStartTime
+	case "event_end_time":
+		return e.EventEndTime
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"eventpass/auth"
+	"eventpass/mailer"
+	"eventpass/model"
+	"eventpass/proto/gen"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const emailVerificationTTL = 24 * time.Hour
+
+func (h *UserHandler) VerifyEmail(ctx context.Context, req *gen.VerifyEmailRequest) (*gen.VerifyEmailResponse, error) {
+	token, err := h.userTokens.ConsumeUserToken(ctx, model.PurposeEmailVerification, auth.HashToken(req.Token))
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Failed to consume verification token: %v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid or expired verification token")
+	}
+
+	if err := h.users.MarkEmailVerified(ctx, token.UserID); err != nil {
+		log.Printf("Failed to mark email verified: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to verify email")
+	}
+
+	return &gen.VerifyEmailResponse{
+		Message: "Email verified successfully",
+	}, nil
+}
+
+// ResendVerification answers the same way whether or not the email is known
+// or already verified, so it cannot be used to probe for accounts.
+func (h *UserHandler) ResendVerification(ctx context.Context, req *gen.ResendVerificationRequest) (*gen.ResendVerificationResponse, error) {
+	resp := &gen.ResendVerificationResponse{
+		Message: "If the email belongs to an unverified account, a new verification link has been sent",
+	}
+
+	user, err := h.users.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.Printf("Failed to get user: %v", err)
+		}
+		return resp, nil
+	}
+	if user.EmailVerifiedAt != nil {
+		return resp, nil
+	}
+
+	// A failure is only logged; reporting it would give the account away
+	if err := h.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to send verification email: %v", err)
+	}
+	return resp, nil
+}
+
+// sendVerification issues a verification token and mails the link to it.
+func (h *UserHandler) sendVerification(ctx context.Context, user model.User) error {
+	token, hash, err := auth.NewOpaqueToken()
+	if err != nil {
+		return err
+	}
+	err = h.userTokens.CreateUserToken(ctx, model.UserToken{
+		TokenID:   uuid.New().String(),
+		UserID:    user.UserID,
+		Purpose:   model.PurposeEmailVerification,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(emailVerificationTTL),
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.mail.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your EventPass email",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address by opening the link below. It expires in %d hours.\n\n%s\n",
+			user.FirstName, int(emailVerificationTTL.Hours()), appURL("verify_token", token)),
+	})
+}
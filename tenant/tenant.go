@@ -0,0 +1,77 @@
+// Package tenant tracks which organization a request acts in.
+package tenant
+
+import (
+	"context"
+	"eventpass/model"
+	intf "eventpass/repository/intf"
+	"log"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Header is the metadata key naming the organization, by ID or slug. The
+// gateway forwards the X-Organization HTTP header there.
+const Header = "x-organization"
+
+type orgKey struct{}
+
+func WithOrg(ctx context.Context, org model.Organization) context.Context {
+	return context.WithValue(ctx, orgKey{}, org)
+}
+
+// OrgFromContext returns the organization attached by the resolver, if any.
+func OrgFromContext(ctx context.Context) (model.Organization, bool) {
+	org, ok := ctx.Value(orgKey{}).(model.Organization)
+	return org, ok
+}
+
+// Find looks an organization up by ID or, failing that, by slug.
+func Find(ctx context.Context, orgs intf.OrganizationRepository, key string) (model.Organization, error) {
+	var org model.Organization
+	var err error
+	if _, parseErr := uuid.Parse(key); parseErr == nil {
+		org, err = orgs.GetOrganization(ctx, key)
+	} else {
+		org, err = orgs.GetOrganizationBySlug(ctx, key)
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.Organization{}, status.Errorf(codes.NotFound, "organization not found")
+		}
+		log.Printf("Failed to get organization: %v", err)
+		return model.Organization{}, status.Errorf(codes.Internal, "failed to get organization")
+	}
+	return org, nil
+}
+
+// Resolver attaches the organization named in the request metadata to the
+// context. Requests that name none act in the default organization.
+type Resolver struct {
+	orgs intf.OrganizationRepository
+}
+
+func NewResolver(orgs intf.OrganizationRepository) *Resolver {
+	return &Resolver{orgs: orgs}
+}
+
+func (r *Resolver) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		key := model.DefaultOrgID
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(Header); len(values) > 0 && values[0] != "" {
+				key = values[0]
+			}
+		}
+
+		org, err := Find(ctx, r.orgs, key)
+		if err != nil {
+			return nil, err
+		}
+		return handler(WithOrg(ctx, org), req)
+	}
+}
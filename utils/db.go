@@ -7,12 +7,23 @@ import (
 	"log"
 	"os"
 
+	"eventpass/migrations"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var DB *pgxpool.Pool
 
+// InitDB connects to the database and brings the schema up to date.
 func InitDB() error {
+	if err := ConnectDB(); err != nil {
+		return err
+	}
+	return migrate()
+}
+
+// ConnectDB opens the connection pool without touching the schema.
+func ConnectDB() error {
 	// First try using DATABASE_URL (for Render or production)
 	if databaseURL := os.Getenv("DATABASE_URL"); databaseURL != "" {
 		log.Println("Using DATABASE_URL from environment")
@@ -28,7 +39,7 @@ func InitDB() error {
 			return fmt.Errorf("failed to ping database: %w", err)
 		}
 		log.Println("✅ Connected to database using DATABASE_URL")
-		return createTables()
+		return nil
 	}
 
 	// Otherwise use local env vars
@@ -57,7 +68,7 @@ func InitDB() error {
 	}
 
 	log.Println("✅ Connected to local database")
-	return createTables()
+	return nil
 }
 
 func CloseDB() {
@@ -67,44 +78,23 @@ func CloseDB() {
 	}
 }
 
-func createTables() error {
-	ctx := context.Background()
-
-	// Users table
-	userTable := `
-	CREATE TABLE IF NOT EXISTS users (
-		user_id VARCHAR(36) PRIMARY KEY,
-		first_name VARCHAR(100) NOT NULL,
-		last_name VARCHAR(100) NOT NULL,
-		username VARCHAR(50) UNIQUE NOT NULL,
-		password VARCHAR(255) NOT NULL,
-		phone VARCHAR(15) NOT NULL,
-		email VARCHAR(100) UNIQUE NOT NULL,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);`
-	if _, err := DB.Exec(ctx, userTable); err != nil {
-		return fmt.Errorf("failed to create users table: %w", err)
+// migrate applies pending schema migrations unless AUTO_MIGRATE is "false",
+// in which case they are left to the migrate subcommand.
+func migrate() error {
+	if getEnv("AUTO_MIGRATE", "true") == "false" {
+		log.Println("AUTO_MIGRATE disabled, skipping schema migrations")
+		return nil
 	}
 
-	// Events table
-	eventTable := `
-	CREATE TABLE IF NOT EXISTS events (
-		event_id VARCHAR(36) PRIMARY KEY,
-		event_title VARCHAR(200) NOT NULL,
-		event_description TEXT,
-		event_location VARCHAR(255) NOT NULL,
-		event_date DATE NOT NULL,
-		event_start_time TIME NOT NULL,
-		event_end_time TIME NOT NULL,
-		created_by VARCHAR(100) NOT NULL,
-		total_slots INTEGER NOT NULL DEFAULT 0,
-		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
-	);`
-	if _, err := DB.Exec(ctx, eventTable); err != nil {
-		return fmt.Errorf("failed to create events table: %w", err)
+	migrator, err := migrations.New(DB)
+	if err != nil {
+		return fmt.Errorf("failed to load migrations: %w", err)
+	}
+	if err := migrator.Up(context.Background()); err != nil {
+		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
-	log.Println("✅ Database tables created successfully")
+	log.Println("✅ Database schema is up to date")
 	return nil
 }
 
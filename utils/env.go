@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"fmt"
 	"log"
+	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -12,3 +15,17 @@ func LoadEnv() {
 		log.Printf("Error loading .env file: %v", err)
 	}
 }
+
+// DurationEnv reads a time.ParseDuration value such as "15m" from key,
+// falling back to defaultValue when it is unset.
+func DurationEnv(key string, defaultValue time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
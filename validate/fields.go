@@ -0,0 +1,144 @@
+package validate
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DateLayout is the format of event dates on the wire
+	DateLayout = "2006-01-02"
+)
+
+// Times are accepted with or without seconds.
+var timeLayouts = []string{"15:04", "15:04:05"}
+
+var (
+	phonePattern    = regexp.MustCompile(`^\+?[0-9]{7,14}$`)
+	usernamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// Required checks that s is not empty.
+func Required(v *Violations, field, s string) bool {
+	if s == "" {
+		v.Add(field, "is required")
+		return false
+	}
+	return true
+}
+
+// MaxLength checks that s is at most n characters.
+func MaxLength(v *Violations, field, s string, n int) bool {
+	if utf8.RuneCountInString(s) > n {
+		v.Add(field, fmt.Sprintf("must be at most %d characters", n))
+		return false
+	}
+	return true
+}
+
+// Length checks that s is between min and max characters.
+func Length(v *Violations, field, s string, min, max int) bool {
+	n := utf8.RuneCountInString(s)
+	if n < min || n > max {
+		v.Add(field, fmt.Sprintf("must be between %d and %d characters", min, max))
+		return false
+	}
+	return true
+}
+
+// Email checks that s is a bare address such as "jane@example.com".
+func Email(v *Violations, field, s string) bool {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s {
+		v.Add(field, "must be a valid email address")
+		return false
+	}
+	return true
+}
+
+// Phone checks that s is 7 to 14 digits with an optional leading "+".
+func Phone(v *Violations, field, s string) bool {
+	if !phonePattern.MatchString(s) {
+		v.Add(field, "must be 7 to 14 digits with an optional leading +")
+		return false
+	}
+	return true
+}
+
+// Username checks that s only uses letters, digits, '_', '.' and '-'.
+func Username(v *Violations, field, s string) bool {
+	if !usernamePattern.MatchString(s) {
+		v.Add(field, "may only contain letters, digits, '_', '.' and '-'")
+		return false
+	}
+	return true
+}
+
+// Date parses s as YYYY-MM-DD.
+func Date(v *Violations, field, s string) (time.Time, bool) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		v.Add(field, "must be a date in YYYY-MM-DD format")
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Time parses s as HH:MM or HH:MM:SS, returned as a duration since midnight.
+func Time(v *Violations, field, s string) (time.Duration, bool) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return time.Duration(t.Hour())*time.Hour +
+				time.Duration(t.Minute())*time.Minute +
+				time.Duration(t.Second())*time.Second, true
+		}
+	}
+	v.Add(field, "must be a time in HH:MM or HH:MM:SS format")
+	return 0, false
+}
+
+// Schedule checks an event's resulting start and end after an update that
+// may have changed only one of its date and times. Both are wall-clock times
+// in the server's zone, whatever location they carry. With future set the
+// start must also be after now.
+func Schedule(v *Violations, prefix string, start, end time.Time, future bool) {
+	if !end.After(start) {
+		v.Add(prefix+"event_end_time", "must be after event_start_time")
+	}
+	if future && !wallClock(start).After(wallClock(time.Now())) {
+		v.Add(prefix+"event_date", "must be in the future")
+	}
+}
+
+// wallClock drops t's zone, keeping its date and time of day.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// NonNegative checks that n is zero or more.
+func NonNegative(v *Violations, field string, n int32) bool {
+	if n < 0 {
+		v.Add(field, "cannot be negative")
+		return false
+	}
+	return true
+}
+
+// OneOf checks that s is one of the allowed values. Empty is allowed; pair
+// with Required when the field is mandatory.
+func OneOf(v *Violations, field, s string, allowed ...string) bool {
+	if s == "" {
+		return true
+	}
+	for _, a := range allowed {
+		if s == a {
+			return true
+		}
+	}
+	v.Add(field, "must be one of "+strings.Join(allowed, ", "))
+	return false
+}
@@ -0,0 +1,93 @@
+// Package validate checks RPC requests before they reach the handlers and
+// reports every problem at once as a BadRequest error detail.
+package validate
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Violations collects the invalid fields of a single request.
+type Violations struct {
+	fields []*errdetails.BadRequest_FieldViolation
+}
+
+// Add records that field is invalid.
+func (v *Violations) Add(field, description string) {
+	v.fields = append(v.fields, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: description,
+	})
+}
+
+// Has reports whether field already has a violation, so dependent checks
+// (e.g. end after start) can be skipped when the inputs are malformed.
+func (v *Violations) Has(field string) bool {
+	for _, f := range v.fields {
+		if f.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an InvalidArgument status carrying the violations, or nil if
+// there are none.
+func (v *Violations) Err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	st := status.New(codes.InvalidArgument, "invalid request: "+v.fields[0].Field+" "+v.fields[0].Description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: v.fields})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// Rule checks one request message.
+type Rule func(req any, v *Violations)
+
+// For adapts a typed check into a Rule.
+func For[T any](check func(req T, v *Violations)) Rule {
+	return func(req any, v *Violations) {
+		if r, ok := req.(T); ok {
+			check(r, v)
+		}
+	}
+}
+
+// Validator runs the rule registered for each RPC, keyed by full method name.
+// Methods without a rule pass through unchecked.
+type Validator struct {
+	rules map[string]Rule
+}
+
+func NewValidator(rules map[string]Rule) *Validator {
+	return &Validator{rules: rules}
+}
+
+// Check validates req against the rule for method.
+func (val *Validator) Check(method string, req any) error {
+	rule, ok := val.rules[method]
+	if !ok {
+		return nil
+	}
+	var v Violations
+	rule(req, &v)
+	return v.Err()
+}
+
+func (val *Validator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := val.Check(info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
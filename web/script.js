@@ -10,7 +10,7 @@ let favoriteEvents = [];
 let currentEventModal = null;
 
 // API Helper function
-async function apiCall(endpoint, method = 'GET', body = null) {
+async function apiCall(endpoint, method = 'GET', body = null, retry = true) {
     showLoading();
     
     try {
@@ -21,12 +21,23 @@ async function apiCall(endpoint, method = 'GET', body = null) {
             },
         };
         
+        if (currentUser?.accessToken) {
+            config.headers['Authorization'] = `Bearer ${currentUser.accessToken}`;
+        }
+        
         if (body) {
             config.body = JSON.stringify(body);
         }
         
         const response = await fetch(`${API_BASE_URL}${endpoint}`, config);
         
+        // Access tokens are short-lived; refresh once and retry
+        if (response.status === 401 && retry && currentUser?.refreshToken) {
+            if (await refreshSession()) {
+                return apiCall(endpoint, method, body, false);
+            }
+        }
+        
         if (!response.ok) {
             const errorData = await response.json().catch(() => ({}));
             throw new Error(errorData.message || `HTTP ${response.status}: ${response.statusText}`);
@@ -38,6 +49,27 @@ async function apiCall(endpoint, method = 'GET', body = null) {
     }
 }
 
+async function refreshSession() {
+    try {
+        const response = await fetch(`${API_BASE_URL}/v1/auth/refresh`, {
+            method: 'POST',
+            headers: { 'Content-Type': 'application/json' },
+            body: JSON.stringify({ refresh_token: currentUser.refreshToken })
+        });
+        if (!response.ok) {
+            return false;
+        }
+        
+        const data = await response.json();
+        currentUser.accessToken = data.access_token;
+        currentUser.refreshToken = data.refresh_token;
+        return true;
+    } catch (error) {
+        console.error('Refresh session error:', error);
+        return false;
+    }
+}
+
 // Loading functions
 function showLoading() {
     document.getElementById('loadingOverlay').classList.remove('hidden');
@@ -121,13 +153,48 @@ function formatTime(timeString) {
     });
 }
 
-function formatPrice(price) {
+function formatPrice(price, currency) {
     return new Intl.NumberFormat('en-US', {
         style: 'currency',
-        currency: 'USD'
+        currency: currency || 'USD'
     }).format(price || 0);
 }
 
+// Prices from the API are in the currency's minor unit (cents for USD)
+function formatMinorPrice(minor, currency) {
+    const format = new Intl.NumberFormat('en-US', {
+        style: 'currency',
+        currency: currency || 'USD'
+    });
+    const digits = format.resolvedOptions().maximumFractionDigits;
+    return format.format((minor || 0) / 10 ** digits);
+}
+
+// Tiers that can be booked right now
+function openTiers(event) {
+    const now = new Date();
+    return (event.ticket_tiers || []).filter(tier =>
+        (tier.available || 0) > 0 &&
+        (!tier.sales_start || new Date(tier.sales_start) <= now) &&
+        (!tier.sales_end || new Date(tier.sales_end) > now)
+    );
+}
+
+function lowestPrice(event) {
+    const tiers = event.ticket_tiers || [];
+    return tiers.length ? Math.min(...tiers.map(tier => Number(tier.price_minor || 0))) : 0;
+}
+
+function eventPriceLabel(event) {
+    const tiers = event.ticket_tiers || [];
+    const lowest = lowestPrice(event);
+    if (lowest === 0) {
+        return 'Free';
+    }
+    const price = formatMinorPrice(lowest, tiers[0].currency);
+    return tiers.length > 1 ? `From ${price}` : price;
+}
+
 // Password functions
 function togglePassword(inputId) {
     const input = document.getElementById(inputId);
@@ -224,36 +291,95 @@ async function register(userData) {
 
 async function login(username, password) {
     try {
+        // Admins authenticate against the admins table; the dashboard is only
+        // shown when that succeeds
+        if (currentUserType === 'admin') {
+            const response = await apiCall('/v1/admins/login', 'POST', {
+                username: username,
+                password: password
+            });
+            const session = await completeMfa(response);
+            
+            currentUser = {
+                username: username,
+                userId: response.admin_id,
+                role: 'admin',
+                accessToken: session.access_token,
+                refreshToken: session.refresh_token
+            };
+            document.getElementById('welcomeAdmin').textContent = `Welcome, ${username}`;
+            hideElement('loginPage');
+            showElement('adminDashboard');
+            showAdminDashboard();
+            
+            await loadEvents();
+            showToast('Login successful!', 'success');
+            return { success: true, data: response };
+        }
+        
         const response = await apiCall('/v1/users/login', 'POST', {
             username: username,
             password: password,
             user_id: ""
         });
+        const session = await completeMfa(response);
         
-        // Handle successful login
-        if (currentUserType === 'admin' && username === 'admin') {
-            currentUser = { username: username, role: 'admin' };
-            document.getElementById('welcomeAdmin').textContent = `Welcome, ${username}`;
-            hideElement('loginPage');
-            showElement('adminDashboard');
-            showAdminDashboard();
-        } else {
-            currentUser = { username: username, role: 'customer' };
-            document.getElementById('welcomeCustomer').textContent = `Welcome, ${username}`;
-            hideElement('loginPage');
-            showElement('customerDashboard');
-            showCustomerDashboard();
-        }
+        currentUser = {
+            username: username,
+            userId: response.user_id,
+            role: 'customer',
+            accessToken: session.access_token,
+            refreshToken: session.refresh_token
+        };
+        document.getElementById('welcomeCustomer').textContent = `Welcome, ${username}`;
+        hideElement('loginPage');
+        showElement('customerDashboard');
+        showCustomerDashboard();
         
-        await loadEvents();
+        await Promise.all([loadEvents(), loadMyBookings()]);
         showToast('Login successful!', 'success');
+        if (!response.email_verified) {
+            showToast('Please verify your email address using the link we sent you.', 'info');
+        }
         return { success: true, data: response };
     } catch (error) {
         console.error('Login error:', error);
+        // Lockouts say when to try again
+        if (error.message.startsWith('too many failed attempts')) throw error;
         throw new Error('Invalid credentials or server error');
     }
 }
 
+// Second login step: returns the response carrying the session tokens
+async function completeMfa(response) {
+    if (!response.mfa_required) return response;
+    
+    if (response.mfa_enrollment_required) {
+        const enrollment = await apiCall('/v1/auth/mfa/enroll', 'POST', { mfa_token: response.mfa_token });
+        
+        // Show the QR code before the prompt blocks the page
+        const qr = document.getElementById('loginError');
+        qr.innerHTML = `<img src="data:image/png;base64,${enrollment.qr_code_png}" alt="Authenticator QR code">`;
+        showElement('loginError');
+        await new Promise(resolve => setTimeout(resolve, 100));
+        
+        const code = prompt(`Two-factor authentication is required. Scan the QR code (or enter ${enrollment.secret}) in your authenticator app, then enter the 6-digit code:`);
+        hideElement('loginError');
+        if (!code) throw new Error('Two-factor enrolment cancelled');
+        
+        const confirmed = await apiCall('/v1/auth/mfa/confirm', 'POST', { mfa_token: response.mfa_token, code: code });
+        alert('Save these recovery codes somewhere safe. Each works once if you lose your authenticator:\n\n' + confirmed.recovery_codes.join('\n'));
+        return confirmed;
+    }
+    
+    const code = prompt('Enter the 6-digit code from your authenticator app, or a recovery code:');
+    if (!code) throw new Error('Two-factor authentication cancelled');
+    const body = /^\d{6}$/.test(code.trim())
+        ? { mfa_token: response.mfa_token, code: code.trim() }
+        : { mfa_token: response.mfa_token, recovery_code: code };
+    return apiCall('/v1/auth/mfa/verify', 'POST', body);
+}
+
 async function createEvent(eventData) {
     try {
         const response = await apiCall('/v1/event/create', 'POST', {
@@ -263,7 +389,6 @@ async function createEvent(eventData) {
             event_date: eventData.event_date,
             event_start_time: eventData.event_start_time,
             event_end_time: eventData.event_end_time,
-            created_by: eventData.created_by,
             total_slots: eventData.total_slots
         });
         
@@ -277,7 +402,9 @@ async function createEvent(eventData) {
 
 async function loadEvents() {
     try {
-        const response = await apiCall('/v1/events?page=1&limit=100');
+        // Customers only see events that are open; admins also see drafts
+        const statusFilter = currentUser?.role === 'admin' ? '' : '&status=published';
+        const response = await apiCall(`/v1/events?page=1&limit=100${statusFilter}`);
         events = response.events || [];
         renderEvents();
         updateStats();
@@ -298,30 +425,43 @@ async function getEventDetails(eventId) {
     }
 }
 
-async function bookEvent(eventId) {
+async function bookEvent(eventId, tierId) {
     try {
-        // Simulate booking since endpoint doesn't exist yet
-        const event = events.find(e => e.event_id === eventId);
-        if (event) {
-            const booking = {
-                ...event,
-                booking_date: new Date().toISOString(),
-                booking_id: Math.random().toString(36).substr(2, 9),
-                status: 'confirmed'
-            };
-            userBookings.push(booking);
-            
-            // Decrease available slots
-            event.total_slots = Math.max(0, event.total_slots - 1);
-        }
+        const response = await apiCall('/v1/bookings', 'POST', {
+            event_id: eventId,
+            ...(tierId ? { tier_id: tierId } : {})
+        });
         
-        return { success: true };
+        await Promise.all([loadMyBookings(), loadEvents()]);
+        return { success: true, data: response };
     } catch (error) {
         console.error('Book event error:', error);
         throw error;
     }
 }
 
+async function loadMyBookings() {
+    try {
+        const response = await apiCall('/v1/bookings?limit=100');
+        userBookings = response.bookings || [];
+    } catch (error) {
+        console.error('Load bookings error:', error);
+        userBookings = [];
+    }
+}
+
+async function cancelBooking(bookingId) {
+    try {
+        const response = await apiCall(`/v1/bookings/${bookingId}/cancel`, 'POST', {});
+        
+        await Promise.all([loadMyBookings(), loadEvents()]);
+        return { success: true, data: response };
+    } catch (error) {
+        console.error('Cancel booking error:', error);
+        throw error;
+    }
+}
+
 // UI Navigation functions
 function showLogin() {
     hideElement('signupPage');
@@ -407,6 +547,11 @@ function updateNavActive(dashboard, index) {
 }
 
 function logout() {
+    if (currentUser?.refreshToken) {
+        apiCall('/v1/auth/logout', 'POST', { refresh_token: currentUser.refreshToken }, false)
+            .catch(error => console.error('Logout error:', error));
+    }
+    
     currentUser = null;
     events = [];
     userBookings = [];
@@ -472,8 +617,7 @@ function sortEvents() {
             sortedEvents.sort((a, b) => b.total_slots - a.total_slots);
             break;
         case 'price':
-            // Since price is not in current API, we'll use slots as proxy
-            sortedEvents.sort((a, b) => a.total_slots - b.total_slots);
+            sortedEvents.sort((a, b) => lowestPrice(a) - lowestPrice(b));
             break;
     }
     
@@ -596,26 +740,45 @@ function renderEventsGrid(eventsList, container, type) {
                         </div>
                         <div class="meta-item">
                             <i class="fas fa-users"></i>
-                            <span>${event.total_slots} slots</span>
+                            <span>${event.available_slots ?? event.total_slots} slots</span>
                         </div>
                     </div>
                     <div class="event-actions">
                         ${type === 'customer' ? `
-                            <div class="event-price">${formatPrice(Math.random() * 100 + 20)}</div>
+                            <div class="event-price">${eventPriceLabel(event)}</div>
                             <button class="btn btn-primary" onclick="event.stopPropagation(); bookEventHandler('${event.event_id}')">
                                 <i class="fas fa-ticket-alt"></i>
                                 Book Now
                             </button>
                         ` : type === 'booking' ? `
                             <div class="booking-status">
-                                <i class="fas fa-check-circle" style="color: var(--success-color);"></i>
-                                Confirmed
+                                ${event.status === 'cancelled' ? `
+                                    <i class="fas fa-times-circle" style="color: var(--error-color);"></i>
+                                    Cancelled
+                                ` : `
+                                    <i class="fas fa-check-circle" style="color: var(--success-color);"></i>
+                                    Confirmed
+                                `}
                             </div>
-                            <button class="btn btn-secondary" onclick="event.stopPropagation(); viewTicket('${event.booking_id}')">
-                                <i class="fas fa-eye"></i>
-                                View Ticket
-                            </button>
+                            ${event.status === 'cancelled' ? '' : `
+                                <button class="btn btn-danger" onclick="event.stopPropagation(); cancelBookingHandler('${event.booking_id}')">
+                                    <i class="fas fa-times"></i>
+                                    Cancel
+                                </button>
+                            `}
                         ` : type === 'manage' ? `
+                            ${event.status === 'draft' ? `
+                                <button class="btn btn-primary" onclick="event.stopPropagation(); publishEventHandler('${event.event_id}')">
+                                    <i class="fas fa-bullhorn"></i>
+                                    Publish
+                                </button>
+                            ` : ''}
+                            ${event.status === 'draft' || event.status === 'published' ? `
+                                <button class="btn btn-secondary" onclick="event.stopPropagation(); cancelEventHandler('${event.event_id}')">
+                                    <i class="fas fa-ban"></i>
+                                    Cancel
+                                </button>
+                            ` : ''}
                             <button class="btn btn-secondary" onclick="event.stopPropagation(); editEventHandler('${event.event_id}')">
                                 <i class="fas fa-edit"></i>
                                 Edit
@@ -684,7 +847,7 @@ function openEventModal(eventId) {
     document.getElementById('modalEventDate').textContent = formatDate(event.event_date);
     document.getElementById('modalEventTime').textContent = `${formatTime(event.event_start_time)} - ${formatTime(event.event_end_time)}`;
     document.getElementById('modalEventLocation').textContent = event.event_location;
-    document.getElementById('modalEventSlots').textContent = `${event.total_slots} slots available`;
+    document.getElementById('modalEventSlots').textContent = `${event.available_slots ?? event.total_slots} slots available`;
     document.getElementById('modalEventDescription').textContent = event.event_description;
     document.getElementById('modalEventImage').src = getEventImage(event.event_title);
     document.getElementById('modalEventCategory').textContent = getEventCategory(event.event_title);
@@ -715,7 +878,18 @@ function bookEventFromModal() {
 // Event handlers
 async function bookEventHandler(eventId) {
     try {
-        await bookEvent(eventId);
+        // Book the cheapest tier still on sale
+        const event = events.find(e => e.event_id === eventId);
+        let tierId;
+        if (event?.ticket_tiers?.length) {
+            const tiers = openTiers(event).sort((a, b) => Number(a.price_minor || 0) - Number(b.price_minor || 0));
+            if (!tiers.length) {
+                showToast('No tickets are on sale for this event', 'error');
+                return;
+            }
+            tierId = tiers[0].tier_id;
+        }
+        await bookEvent(eventId, tierId);
         showToast('Event booked successfully!', 'success');
         renderEvents();
         renderMyBookings();
@@ -724,6 +898,21 @@ async function bookEventHandler(eventId) {
     }
 }
 
+async function cancelBookingHandler(bookingId) {
+    if (!confirm('Are you sure you want to cancel this booking?')) {
+        return;
+    }
+    
+    try {
+        await cancelBooking(bookingId);
+        showToast('Booking cancelled', 'info');
+        renderEvents();
+        renderMyBookings();
+    } catch (error) {
+        showToast('Failed to cancel booking: ' + error.message, 'error');
+    }
+}
+
 function toggleFavorite(eventId) {
     const event = events.find(e => e.event_id === eventId);
     if (!event) return;
@@ -742,14 +931,88 @@ function toggleFavorite(eventId) {
     renderFavorites();
 }
 
-function editEventHandler(eventId) {
-    showToast('Edit functionality will be implemented soon', 'info');
+async function editEventHandler(eventId) {
+    const event = events.find(e => e.event_id === eventId);
+    if (!event) return;
+    
+    const title = prompt('Event title', event.event_title);
+    if (title === null) return;
+    const location = prompt('Event location', event.event_location);
+    if (location === null) return;
+    const slots = prompt('Total slots', event.total_slots);
+    if (slots === null) return;
+    
+    try {
+        // The version makes the update fail if someone else changed the event
+        await apiCall(`/v1/events/${eventId}`, 'PATCH', {
+            event: {
+                event_title: title,
+                event_location: location,
+                total_slots: parseInt(slots)
+            },
+            update_mask: 'eventTitle,eventLocation,totalSlots',
+            version: event.version
+        });
+        showToast('Event updated successfully!', 'success');
+    } catch (error) {
+        showToast('Failed to update event: ' + error.message, 'error');
+    }
+    
+    await loadEvents();
+    renderManageEvents();
+}
+
+async function publishEventHandler(eventId) {
+    try {
+        await apiCall(`/v1/events/${eventId}/publish`, 'POST', {});
+        showToast('Event published', 'success');
+    } catch (error) {
+        showToast('Failed to publish event: ' + error.message, 'error');
+    }
+    
+    await loadEvents();
+    renderManageEvents();
 }
 
-function deleteEventHandler(eventId) {
-    if (confirm('Are you sure you want to delete this event?')) {
-        showToast('Delete functionality will be implemented soon', 'info');
+async function cancelEventHandler(eventId) {
+    const reason = prompt('Why is this event being cancelled? Attendees will see this reason.');
+    if (!reason) return;
+    
+    try {
+        const response = await apiCall(`/v1/events/${eventId}/cancel`, 'POST', { reason: reason });
+        showToast(`Event cancelled, ${response.cancelled_bookings || 0} bookings cancelled`, 'info');
+    } catch (error) {
+        showToast('Failed to cancel event: ' + error.message, 'error');
     }
+    
+    await loadEvents();
+    renderManageEvents();
+}
+
+async function deleteEventHandler(eventId) {
+    if (!confirm('Are you sure you want to delete this event?')) {
+        return;
+    }
+    
+    try {
+        await apiCall(`/v1/events/${eventId}`, 'DELETE');
+        showToast('Event deleted successfully', 'success');
+    } catch (error) {
+        if (error.message.includes('active bookings') &&
+            confirm(`${error.message}. Delete it anyway and remove those bookings?`)) {
+            try {
+                await apiCall(`/v1/events/${eventId}?force=true`, 'DELETE');
+                showToast('Event deleted successfully', 'success');
+            } catch (forceError) {
+                showToast('Failed to delete event: ' + forceError.message, 'error');
+            }
+        } else {
+            showToast('Failed to delete event: ' + error.message, 'error');
+        }
+    }
+    
+    await loadEvents();
+    renderManageEvents();
 }
 
 function viewTicket(bookingId) {
@@ -792,7 +1055,11 @@ function updateStats() {
     if (currentUser?.role === 'admin') {
         document.getElementById('totalEvents').textContent = events.length;
         document.getElementById('totalBookings').textContent = userBookings.length;
-        document.getElementById('totalRevenue').textContent = formatPrice(events.length * 50);
+        // Tickets sold so far at their tier's price
+        const tiers = events.flatMap(event => event.ticket_tiers || []);
+        const revenue = tiers.reduce((sum, tier) =>
+            sum + (tier.capacity - (tier.available || 0)) * Number(tier.price_minor || 0), 0);
+        document.getElementById('totalRevenue').textContent = formatMinorPrice(revenue, tiers.find(tier => tier.currency)?.currency);
         document.getElementById('avgRating').textContent = '4.8';
     }
 }
@@ -860,8 +1127,7 @@ document.getElementById('createEventForm').addEventListener('submit', async (e)
         event_date: document.getElementById('eventDate').value,
         event_start_time: document.getElementById('startTime').value,
         event_end_time: document.getElementById('endTime').value,
-        total_slots: parseInt(document.getElementById('totalSlots').value),
-        created_by: currentUser.username
+        total_slots: parseInt(document.getElementById('totalSlots').value)
     };
     
     const createBtn = document.getElementById('createEventBtn');
@@ -910,8 +1176,66 @@ document.getElementById('priceRange')?.addEventListener('input', (e) => {
     document.getElementById('priceValue').textContent = formatPrice(e.target.value);
 });
 
+// Password reset
+async function forgotPasswordHandler() {
+    const email = prompt('Enter the email address of your account:');
+    if (!email) return;
+    
+    try {
+        const response = await apiCall('/v1/auth/password-reset', 'POST', { email: email });
+        showToast(response.message, 'info');
+    } catch (error) {
+        showToast('Failed to request password reset: ' + error.message, 'error');
+    }
+}
+
+async function resendVerificationHandler() {
+    const email = prompt('Enter the email address you registered with:');
+    if (!email) return;
+    
+    try {
+        const response = await apiCall('/v1/auth/verify-email/resend', 'POST', { email: email });
+        showToast(response.message, 'info');
+    } catch (error) {
+        showToast('Failed to resend verification email: ' + error.message, 'error');
+    }
+}
+
+// Emailed links point back here with ?reset_token=... or ?verify_token=...
+async function handleEmailLinks() {
+    const params = new URLSearchParams(window.location.search);
+    const resetToken = params.get('reset_token');
+    const verifyToken = params.get('verify_token');
+    if (!resetToken && !verifyToken) return;
+    
+    // Don't leave the token in the address bar or history
+    window.history.replaceState({}, document.title, window.location.pathname);
+    
+    if (verifyToken) {
+        try {
+            await apiCall('/v1/auth/verify-email', 'POST', { token: verifyToken });
+            showToast('Email verified. Thanks!', 'success');
+        } catch (error) {
+            showToast('Failed to verify email: ' + error.message, 'error');
+        }
+        return;
+    }
+    
+    const newPassword = prompt('Choose a new password (at least 8 characters):');
+    if (!newPassword) return;
+    
+    try {
+        await apiCall('/v1/auth/password-reset/confirm', 'POST', { token: resetToken, new_password: newPassword });
+        showToast('Password reset. Please sign in with your new password.', 'success');
+    } catch (error) {
+        showToast('Failed to reset password: ' + error.message, 'error');
+    }
+}
+
 // Initialize the application
 document.addEventListener('DOMContentLoaded', () => {
+    handleEmailLinks();
+    
     // Set minimum date for event creation to today
     const today = new Date().toISOString().split('T')[0];
     const eventDateInput = document.getElementById('eventDate');